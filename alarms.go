@@ -0,0 +1,68 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Event categories of alarm triggers
+const (
+	EventCategoryAlarm     = "ALARM"
+	EventCategoryIntrusion = "INTRUSION"
+)
+
+// AlarmEvent is an alarm trigger. AcknowledgedBy and AcknowledgedAt are
+// only set once someone has acknowledged the alarm.
+type AlarmEvent struct {
+	Event
+	Acknowledged   bool
+	AcknowledgedBy string
+	AcknowledgedAt time.Time
+}
+
+type alarmAcknowledgement struct {
+	EventID        string    `json:"eventId"`
+	AcknowledgedBy string    `json:"acknowledgedBy"`
+	AcknowledgedAt time.Time `json:"acknowledgedTime"`
+}
+
+// RecentAlarms returns up to limit of the latest alarm triggers, newest
+// first, with the triggering device in DeviceLabel. limit 0 returns every
+// alarm in the event log.
+func (v *Verisure) RecentAlarms(ctx context.Context, limit int) ([]AlarmEvent, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := EventOptions{Limit: limit}.WithCategories(EventCategoryAlarm, EventCategoryIntrusion)
+	es, err := v.allEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var acks []alarmAcknowledgement
+	path := fmt.Sprintf("/installation/%s/alarm/acknowledgements", giid)
+	if err := v.call(ctx, "alarm acknowledgements", http.MethodGet, path, nil, &acks); err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	acked := make(map[string]alarmAcknowledgement, len(acks))
+	for _, a := range acks {
+		acked[a.EventID] = a
+	}
+
+	as := make([]AlarmEvent, 0, len(es))
+	for _, e := range es {
+		a := AlarmEvent{Event: e}
+		if ack, ok := acked[e.EventID]; ok {
+			a.Acknowledged = true
+			a.AcknowledgedBy = ack.AcknowledgedBy
+			a.AcknowledgedAt = ack.AcknowledgedAt
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
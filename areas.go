@@ -0,0 +1,43 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Area (zone) of the installation and the devices in it
+type Area struct {
+	ID           string   `json:"zoneId"`
+	Name         string   `json:"name"`
+	DeviceLabels []string `json:"deviceLabels"`
+}
+
+// Areas returns the configured areas. Installations without zones are
+// reported as a single implicit area holding every device.
+func (v *Verisure) Areas(ctx context.Context) ([]Area, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var as []Area
+	path := fmt.Sprintf("/installation/%s/zones", giid)
+	if err := v.call(ctx, "areas", http.MethodGet, path, nil, &as); err != nil {
+		return nil, err
+	}
+	if len(as) > 0 {
+		return as, nil
+	}
+
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a := Area{DeviceLabels: make([]string, 0, len(ds))}
+	for _, d := range ds {
+		a.DeviceLabels = append(a.DeviceLabels, d.DeviceLabel)
+	}
+
+	return []Area{a}, nil
+}
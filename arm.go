@@ -0,0 +1,256 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ArmStatusType of the alarm
+type ArmStatusType string
+
+// Arm states
+const (
+	ArmDisarmed  ArmStatusType = "DISARMED"
+	ArmArmedHome ArmStatusType = "ARMED_HOME"
+	ArmArmedAway ArmStatusType = "ARMED_AWAY"
+)
+
+type armStateCommand struct {
+	Code  string        `json:"code"`
+	State ArmStatusType `json:"state"`
+}
+
+type armStateTransaction struct {
+	ID string `json:"armStateChangeTransactionId"`
+}
+
+// ArmStateReliable reports whether the installation's arm state can be
+// trusted and changed through the API
+func (o Overview) ArmStateReliable() bool {
+	return o.ArmstateCompatible
+}
+
+// armTransitions from each arm state
+var armTransitions = map[ArmStatusType][]ArmStatusType{
+	ArmDisarmed:  {ArmArmedHome, ArmArmedAway},
+	ArmArmedHome: {ArmDisarmed, ArmArmedAway},
+	ArmArmedAway: {ArmDisarmed},
+}
+
+// AllowedArmTransitions returns the states SetArmState may move to from
+// the current arm state. Pass the installation's SupportedArmStates to
+// leave out states it cannot be armed to; without them states such as
+// ArmArmedHome are offered even where SetArmState would reject them with
+// ErrUnsupportedArmState. It is empty if the current state is unknown or
+// the arm state is not API compatible.
+func (o Overview) AllowedArmTransitions(supported ...ArmStatusType) []ArmStatusType {
+	if !o.ArmStateReliable() {
+		return nil
+	}
+
+	var ts []ArmStatusType
+	for _, s := range armTransitions[ArmStatusType(o.ArmState.StatusType)] {
+		if len(supported) == 0 || containsArmState(supported, s) {
+			ts = append(ts, s)
+		}
+	}
+	return ts
+}
+
+// SetArmState arms or disarms the alarm and waits for the panel to accept
+// the change. Installations whose arm state is not API compatible give
+// ErrArmStateIncompatible, and states missing from SupportedArmStates
+// ErrUnsupportedArmState, without sending the command. Compatibility is
+// taken from the latest Overview, which is only fetched if there is none. Arming with an
+// empty code requires quick arm to be enabled in ArmSettings, otherwise
+// ErrCodeRequired is returned; disarming always requires a code.
+func (v *Verisure) SetArmState(ctx context.Context, code string, state ArmStatusType) error {
+	if code == "" && state != ArmDisarmed {
+		s, err := v.ArmSettings(ctx)
+		if err != nil {
+			return err
+		}
+		if !s.QuickArm {
+			return ErrCodeRequired
+		}
+	} else if err := v.checkCode(code); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	if state != ArmDisarmed {
+		states, err := v.SupportedArmStates(ctx)
+		if err != nil {
+			return err
+		}
+		if !containsArmState(states, state) {
+			return ErrUnsupportedArmState
+		}
+	}
+
+	compatible, err := v.armStateCompatible(ctx, giid)
+	if err != nil {
+		return err
+	}
+	if !compatible {
+		return ErrArmStateIncompatible
+	}
+
+	var t armStateTransaction
+	path := fmt.Sprintf("/installation/%s/armstate/code", giid)
+	if err := v.call(ctx, "armstate", http.MethodPut, path, armStateCommand{code, state}, &t); err != nil {
+		return err
+	}
+
+	// Keep tracking the change if ctx ends first, the panel still applies it
+	tx := v.startArmTransaction(t.ID, state)
+	path = fmt.Sprintf("/installation/%s/code/result/%s", giid, t.ID)
+	err = v.waitTransaction(ctx, "armstate", path)
+	if ctx.Err() == nil {
+		v.endArmTransaction(tx)
+	}
+	return err
+}
+
+// ArmAt blocks until t and then calls SetArmState. The schedule is kept in
+// this process only, so it must stay alive until t; cancel ctx to abort.
+func (v *Verisure) ArmAt(ctx context.Context, t time.Time, code string, state ArmStatusType) error {
+	timer := time.NewTimer(t.Sub(time.Now()))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	return v.SetArmState(ctx, code, state)
+}
+
+// ChangedVia is the channel through which the arm state was last changed
+type ChangedVia string
+
+// Known channels. ArmState.ChangedVia keeps the raw value for others.
+const (
+	ChangedViaUnknown ChangedVia = ""
+	ChangedViaCode    ChangedVia = "CODE"
+	ChangedViaTag     ChangedVia = "TAG"
+	ChangedViaApp     ChangedVia = "APP"
+	ChangedViaAuto    ChangedVia = "AUTO"
+)
+
+var changedVia = map[string]ChangedVia{
+	"CODE":     ChangedViaCode,
+	"TAG":      ChangedViaTag,
+	"RFID":     ChangedViaTag,
+	"APP":      ChangedViaApp,
+	"MOBILE":   ChangedViaApp,
+	"WEB":      ChangedViaApp,
+	"AUTO":     ChangedViaAuto,
+	"AUTOARM":  ChangedViaAuto,
+	"TIMER":    ChangedViaAuto,
+	"SCHEDULE": ChangedViaAuto,
+}
+
+// Channel maps ChangedVia to one of the known channels, or
+// ChangedViaUnknown
+func (a ArmState) Channel() ChangedVia {
+	return changedVia[a.ChangedVia]
+}
+
+// ArmedByUser reports whether a person changed the arm state, by code, tag
+// or app, rather than an automation
+func (a ArmState) ArmedByUser() bool {
+	switch a.Channel() {
+	case ChangedViaCode, ChangedViaTag, ChangedViaApp:
+		return true
+	}
+	return false
+}
+
+type armCapabilities struct {
+	ArmHomeSupported bool `json:"armHomeSupported"`
+}
+
+// SupportedArmStates returns the arm states the installation supports.
+// Every installation can be disarmed and armed away, not all can be armed
+// home. The result is cached per installation until the next Login.
+func (v *Verisure) SupportedArmStates(ctx context.Context) ([]ArmStatusType, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+	v.shared().mu.Lock()
+	states, ok := v.armStates[giid]
+	v.shared().mu.Unlock()
+	if ok {
+		return append([]ArmStatusType(nil), states...), nil
+	}
+
+	var c armCapabilities
+	path := fmt.Sprintf("/installation/%s/armstate/capabilities", giid)
+	if err := v.call(ctx, "arm capabilities", http.MethodGet, path, nil, &c); err != nil {
+		return nil, err
+	}
+
+	states = []ArmStatusType{ArmDisarmed, ArmArmedAway}
+	if c.ArmHomeSupported {
+		states = []ArmStatusType{ArmDisarmed, ArmArmedHome, ArmArmedAway}
+	}
+	v.shared().mu.Lock()
+	if v.armStates == nil {
+		v.armStates = make(map[string][]ArmStatusType)
+	}
+	v.armStates[giid] = states
+	v.shared().mu.Unlock()
+
+	return append([]ArmStatusType(nil), states...), nil
+}
+
+// armStateCompatible reports whether installation giid's arm state is API
+// compatible as of the latest overview, fetching one if there is none yet
+func (v *Verisure) armStateCompatible(ctx context.Context, giid string) (bool, error) {
+	v.shared().mu.Lock()
+	compatible, ok := v.armCompat[giid]
+	v.shared().mu.Unlock()
+	if ok {
+		return compatible, nil
+	}
+
+	o, err := v.Overview(ctx)
+	return o.ArmStateReliable(), err
+}
+
+// rememberArmCompat keeps the arm state compatibility from the raw overview
+// of installation giid, if the overview reports it
+func (v *Verisure) rememberArmCompat(giid string, raw json.RawMessage) {
+	var c struct {
+		ArmstateCompatible *bool `json:"armstateCompatible"`
+	}
+	if json.Unmarshal(raw, &c) != nil || c.ArmstateCompatible == nil {
+		return
+	}
+
+	v.shared().mu.Lock()
+	if v.armCompat == nil {
+		v.armCompat = make(map[string]bool)
+	}
+	v.armCompat[giid] = *c.ArmstateCompatible
+	v.shared().mu.Unlock()
+}
+
+func containsArmState(states []ArmStatusType, state ArmStatusType) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
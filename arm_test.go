@@ -0,0 +1,191 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestArmStateIncompatible(t *testing.T) {
+	sent := false
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview":              fixture(t, "overview_incompatible.json"),
+		"GET /installation/1/armstate/capabilities": ok(`{"armHomeSupported":true}`),
+		"PUT /installation/1/armstate/code": func(w http.ResponseWriter, r *http.Request) {
+			sent = true
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	o, err := v.Overview(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.ArmStateReliable() || o.AllowedArmTransitions() != nil {
+		t.Errorf("reliable %v, transitions %v", o.ArmStateReliable(), o.AllowedArmTransitions())
+	}
+
+	for _, state := range []ArmStatusType{ArmArmedAway, ArmDisarmed} {
+		if err := v.SetArmState(ctx, "1234", state); err != ErrArmStateIncompatible {
+			t.Errorf("%s: got %v, want ErrArmStateIncompatible", state, err)
+		}
+	}
+	if sent {
+		t.Error("command sent to an incompatible installation")
+	}
+}
+
+func TestAllowedArmTransitions(t *testing.T) {
+	twoState := []ArmStatusType{ArmDisarmed, ArmArmedAway}
+	for _, tc := range []struct {
+		current   string
+		supported []ArmStatusType
+		want      []ArmStatusType
+	}{
+		{string(ArmDisarmed), nil, []ArmStatusType{ArmArmedHome, ArmArmedAway}},
+		{string(ArmArmedHome), nil, []ArmStatusType{ArmDisarmed, ArmArmedAway}},
+		{string(ArmArmedAway), nil, []ArmStatusType{ArmDisarmed}},
+		{"UNKNOWN", nil, nil},
+		{string(ArmDisarmed), twoState, []ArmStatusType{ArmArmedAway}},
+		{string(ArmArmedHome), twoState, []ArmStatusType{ArmDisarmed, ArmArmedAway}},
+	} {
+		o := Overview{ArmState: ArmState{StatusType: tc.current}, ArmstateCompatible: true}
+		got := o.AllowedArmTransitions(tc.supported...)
+		if !reflect.DeepEqual(got, tc.want) && !(len(got) == 0 && len(tc.want) == 0) {
+			t.Errorf("from %s: %v, want %v", tc.current, got, tc.want)
+		}
+
+		// Callers may modify the result
+		if len(got) > 0 {
+			got[0] = "CHANGED"
+			if o.AllowedArmTransitions(tc.supported...)[0] == "CHANGED" {
+				t.Errorf("from %s: result shares the transition table", tc.current)
+			}
+		}
+	}
+}
+
+func TestQuickArm(t *testing.T) {
+	for _, tc := range []struct {
+		fixture string
+		err     error
+	}{
+		{"armsettings_quickarm_on.json", nil},
+		{"armsettings_quickarm_off.json", ErrCodeRequired},
+	} {
+		var sent *armStateCommand
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/armsettings":           fixture(t, tc.fixture),
+			"GET /installation/1/armstate/capabilities": ok(`{}`),
+			"GET /installation/1/overview":              ok(`{"armState":{"statusType":"DISARMED"},"armstateCompatible":true}`),
+			"PUT /installation/1/armstate/code": func(w http.ResponseWriter, r *http.Request) {
+				sent = new(armStateCommand)
+				json.NewDecoder(r.Body).Decode(sent)
+				w.Write([]byte(`{"armStateChangeTransactionId":"tx"}`))
+			},
+			"GET /installation/1/code/result/tx": ok(`{"result":"OK"}`),
+		})
+
+		v := login(t, s)
+		ctx := context.Background()
+		if err := v.SetArmState(ctx, "", ArmArmedAway); err != tc.err {
+			t.Errorf("%s: got %v, want %v", tc.fixture, err, tc.err)
+		}
+		if tc.err == nil && (sent == nil || sent.Code != "" || sent.State != ArmArmedAway) {
+			t.Errorf("%s: sent %+v", tc.fixture, sent)
+		}
+		if tc.err != nil && sent != nil {
+			t.Errorf("%s: command sent without quick arm", tc.fixture)
+		}
+		if err := v.SetArmState(ctx, "", ArmDisarmed); err != ErrCodeRequired {
+			t.Errorf("%s: disarm without code: %v", tc.fixture, err)
+		}
+		s.Close()
+	}
+}
+
+func TestSupportedArmStates(t *testing.T) {
+	for _, tc := range []struct {
+		fixture string
+		want    []ArmStatusType
+		err     error
+	}{
+		{"capabilities_two_state.json", []ArmStatusType{ArmDisarmed, ArmArmedAway}, ErrUnsupportedArmState},
+		{"capabilities_three_state.json", []ArmStatusType{ArmDisarmed, ArmArmedHome, ArmArmedAway}, nil},
+	} {
+		fetches, puts := 0, 0
+		caps := fixture(t, tc.fixture)
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/armstate/capabilities": func(w http.ResponseWriter, r *http.Request) {
+				fetches++
+				caps(w, r)
+			},
+			"GET /installation/1/overview": ok(`{"armState":{"statusType":"DISARMED"},"armstateCompatible":true}`),
+			"PUT /installation/1/armstate/code": func(w http.ResponseWriter, r *http.Request) {
+				puts++
+				w.Write([]byte(`{"armStateChangeTransactionId":"tx"}`))
+			},
+			"GET /installation/1/code/result/tx": ok(`{"result":"OK"}`),
+		})
+
+		v := login(t, s)
+		ctx := context.Background()
+		for i := 0; i < 2; i++ {
+			states, err := v.SupportedArmStates(ctx)
+			if err != nil || !reflect.DeepEqual(states, tc.want) {
+				t.Errorf("%s: %v, %v", tc.fixture, states, err)
+			}
+		}
+		if fetches != 1 {
+			t.Errorf("%s: capabilities fetched %d times", tc.fixture, fetches)
+		}
+
+		if err := v.SetArmState(ctx, "1234", ArmArmedHome); err != tc.err {
+			t.Errorf("%s: arm home: got %v, want %v", tc.fixture, err, tc.err)
+		}
+		if sent := puts > 0; sent != (tc.err == nil) {
+			t.Errorf("%s: %d commands sent", tc.fixture, puts)
+		}
+		s.Close()
+	}
+}
+
+func TestSetArmStateLookups(t *testing.T) {
+	var mu sync.Mutex
+	gets := make(map[string]int)
+	counted := func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			gets[r.URL.Path]++
+			mu.Unlock()
+			h(w, r)
+		}
+	}
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview":              counted(ok(`{"armState":{"statusType":"DISARMED"},"armstateCompatible":true}`)),
+		"GET /installation/1/armstate/capabilities": counted(fixture(t, "capabilities_three_state.json")),
+		"PUT /installation/1/armstate/code":         ok(`{"armStateChangeTransactionId":"tx"}`),
+		"GET /installation/1/code/result/tx":        ok(`{"result":"OK"}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	for _, state := range []ArmStatusType{ArmArmedHome, ArmDisarmed, ArmArmedAway} {
+		if err := v.SetArmState(ctx, "1234", state); err != nil {
+			t.Fatalf("%s: %v", state, err)
+		}
+	}
+
+	// Both lookups are made once, by the first command, and then reused
+	mu.Lock()
+	defer mu.Unlock()
+	if gets["/installation/1/overview"] != 1 || gets["/installation/1/armstate/capabilities"] != 1 {
+		t.Errorf("lookups %v", gets)
+	}
+}
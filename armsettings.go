@@ -0,0 +1,95 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Allowed range for the entry and exit delays
+const (
+	MinArmDelay = 0
+	MaxArmDelay = 180 * time.Second
+)
+
+// ArmSettings of the installation
+type ArmSettings struct {
+	EntryDelay time.Duration
+	ExitDelay  time.Duration
+	QuickArm   bool
+}
+
+type armSettingsJSON struct {
+	EntryDelay int  `json:"entryDelay"`
+	ExitDelay  int  `json:"exitDelay"`
+	QuickArm   bool `json:"quickArm"`
+}
+
+// MarshalJSON encodes the delays in whole seconds as the API expects
+func (s ArmSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(armSettingsJSON{
+		EntryDelay: int(s.EntryDelay / time.Second),
+		ExitDelay:  int(s.ExitDelay / time.Second),
+		QuickArm:   s.QuickArm})
+}
+
+// UnmarshalJSON decodes the delays from whole seconds
+func (s *ArmSettings) UnmarshalJSON(data []byte) error {
+	var j armSettingsJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	s.EntryDelay = time.Duration(j.EntryDelay) * time.Second
+	s.ExitDelay = time.Duration(j.ExitDelay) * time.Second
+	s.QuickArm = j.QuickArm
+
+	return nil
+}
+
+// Validate checks the delays are within the range the API accepts
+func (s ArmSettings) Validate() error {
+	if s.EntryDelay < MinArmDelay || s.EntryDelay > MaxArmDelay {
+		return fmt.Errorf("arm settings: entry delay %s out of range", s.EntryDelay)
+	}
+	if s.ExitDelay < MinArmDelay || s.ExitDelay > MaxArmDelay {
+		return fmt.Errorf("arm settings: exit delay %s out of range", s.ExitDelay)
+	}
+
+	return nil
+}
+
+// ArmSettings ...
+func (v *Verisure) ArmSettings(ctx context.Context) (ArmSettings, error) {
+	var s ArmSettings
+	giid, err := v.giid()
+	if err != nil {
+		return s, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/armsettings", giid)
+	err = v.call(ctx, "arm settings", http.MethodGet, path, nil, &s)
+	return s, err
+}
+
+// SetArmSettings updates the delays and quick arm toggle. Owner only,
+// others get ErrPermissionDenied.
+func (v *Verisure) SetArmSettings(ctx context.Context, s ArmSettings) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	if err := v.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/armsettings", giid)
+	return v.call(ctx, "arm settings", http.MethodPut, path, s, nil)
+}
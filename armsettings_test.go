@@ -0,0 +1,42 @@
+package verisure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSetArmSettings(t *testing.T) {
+	for _, tc := range []struct {
+		detail   string
+		settings ArmSettings
+		err      bool
+		body     string
+	}{
+		{"installation_member.json", ArmSettings{ExitDelay: 30 * time.Second}, true, ""},
+		{"installation_owner.json", ArmSettings{ExitDelay: time.Hour}, true, ""},
+		{"installation_owner.json", ArmSettings{EntryDelay: 15 * time.Second, ExitDelay: 30 * time.Second, QuickArm: true}, false,
+			`{"entryDelay":15,"exitDelay":30,"quickArm":true}`},
+	} {
+		var body string
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/": fixture(t, tc.detail),
+			"PUT /installation/1/armsettings": func(w http.ResponseWriter, r *http.Request) {
+				bs, _ := ioutil.ReadAll(r.Body)
+				body = string(bs)
+			},
+		})
+
+		v := login(t, s)
+		err := v.SetArmSettings(context.Background(), tc.settings)
+		if (err != nil) != tc.err {
+			t.Errorf("%s %+v: error %v", tc.detail, tc.settings, err)
+		}
+		if body != tc.body {
+			t.Errorf("%s %+v: sent %q, want %q", tc.detail, tc.settings, body, tc.body)
+		}
+		s.Close()
+	}
+}
@@ -0,0 +1,68 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ArmTransaction is an arm state change the panel has not yet confirmed.
+// Result is the panel's latest answer, empty or NO_DATA while pending.
+type ArmTransaction struct {
+	ID      string
+	State   ArmStatusType
+	Started time.Time
+	Result  string
+}
+
+// CurrentArmTransaction returns the arm state change started by
+// SetArmState on this client that is still in progress, or nil if there is
+// none. Changes made by other clients are not tracked.
+func (v *Verisure) CurrentArmTransaction(ctx context.Context) (*ArmTransaction, error) {
+	v.shared().mu.Lock()
+	tx := v.armTx
+	v.shared().mu.Unlock()
+	if tx == nil {
+		return nil, nil
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var t TransactionResult
+	path := fmt.Sprintf("/installation/%s/code/result/%s", giid, tx.ID)
+	if err := v.call(ctx, "armstate", http.MethodGet, path, nil, &t); err != nil {
+		return nil, err
+	}
+
+	switch t.Result {
+	case "NO_DATA", "":
+	default:
+		v.endArmTransaction(tx)
+		return nil, nil
+	}
+
+	cur := *tx
+	cur.Result = t.Result
+	return &cur, nil
+}
+
+func (v *Verisure) startArmTransaction(id string, state ArmStatusType) *ArmTransaction {
+	tx := &ArmTransaction{ID: id, State: state, Started: time.Now()}
+	v.shared().mu.Lock()
+	v.armTx = tx
+	v.shared().mu.Unlock()
+	return tx
+}
+
+// endArmTransaction forgets tx unless a newer transaction replaced it
+func (v *Verisure) endArmTransaction(tx *ArmTransaction) {
+	v.shared().mu.Lock()
+	if v.armTx == tx {
+		v.armTx = nil
+	}
+	v.shared().mu.Unlock()
+}
@@ -0,0 +1,174 @@
+package verisure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var authURL = "https://automation01.verisure.com/auth"
+
+// refreshMargin before expiry at which the access token is refreshed
+var refreshMargin = time.Minute
+
+// Token from the bearer token login used by shards that no longer offer
+// cookie login
+type Token struct {
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// TokenStore persists bearer tokens across restarts. LoadToken returns a
+// zero Token when nothing is stored; SaveToken is given a zero Token on
+// Logout.
+type TokenStore interface {
+	LoadToken() (Token, error)
+	SaveToken(Token) error
+}
+
+type tokenJSON struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	MaxAge       int    `json:"accessTokenMaxAgeSeconds"`
+}
+
+type refreshJSON struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// WithAuthURL replaces the auth service used for bearer token login
+func WithAuthURL(u string) Option {
+	return func(v *Verisure) {
+		v.authURL = strings.TrimRight(u, "/")
+	}
+}
+
+// WithTokenStore persists bearer tokens in s. Login first tries a stored
+// token and only uses the password if that no longer works.
+func WithTokenStore(s TokenStore) Option {
+	return func(v *Verisure) {
+		v.tokens = s
+	}
+}
+
+// tokenLogin logs in through the auth service and keeps the bearer token
+// for subsequent requests
+func (v *Verisure) tokenLogin(ctx context.Context, username, password string) error {
+	req, err := v.newRequest(http.MethodPost, v.authURL+"/login", nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(username, password)
+
+	return v.requestToken(ctx, "token login", req)
+}
+
+// refreshToken renews the access token shortly before it expires. Tokens
+// without a known expiry are used until rejected. Concurrent callers wait
+// for a single refresh, since a refresh token can only be spent once.
+func (v *Verisure) refreshToken(ctx context.Context) error {
+	if !v.tokenExpiring() {
+		return nil
+	}
+
+	v.shared().refresh.Lock()
+	defer v.shared().refresh.Unlock()
+	if !v.tokenExpiring() {
+		return nil
+	}
+	t := v.currentToken()
+
+	bs, err := json.Marshal(refreshJSON{t.RefreshToken})
+	if err != nil {
+		return err
+	}
+	req, err := v.newRequest(http.MethodPost, v.authURL+"/token", bytes.NewReader(bs))
+	if err != nil {
+		return err
+	}
+	req.Header.Del("Authorization")
+
+	return v.requestToken(ctx, "token refresh", req)
+}
+
+func (v *Verisure) requestToken(ctx context.Context, name string, req *http.Request) error {
+	res, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := checkStatus(name, res); err != nil {
+		return err
+	}
+
+	var j tokenJSON
+	if err := json.NewDecoder(v.limitBody(res.Body)).Decode(&j); err != nil {
+		return err
+	}
+	t := &Token{AccessToken: j.AccessToken, RefreshToken: j.RefreshToken}
+	if j.MaxAge > 0 {
+		t.Expiry = time.Now().Add(time.Duration(j.MaxAge) * time.Second)
+	}
+	v.shared().mu.Lock()
+	v.token = t
+	v.shared().mu.Unlock()
+
+	return v.saveToken()
+}
+
+// tokenExpiring reports whether the bearer token is refreshable and due
+func (v *Verisure) tokenExpiring() bool {
+	t := v.currentToken()
+	return t != nil && t.RefreshToken != "" && !t.Expiry.IsZero() &&
+		!time.Now().Add(refreshMargin).Before(t.Expiry)
+}
+
+// currentToken returns the bearer token, nil when using cookie auth
+func (v *Verisure) currentToken() *Token {
+	v.shared().mu.Lock()
+	defer v.shared().mu.Unlock()
+	return v.token
+}
+
+// resumeToken logs in with a stored token, reporting whether it worked
+func (v *Verisure) resumeToken(ctx context.Context, username string) bool {
+	if v.tokens == nil || len(v.baseURLs) == 0 {
+		return false
+	}
+
+	t, err := v.tokens.LoadToken()
+	if err != nil || t.AccessToken == "" {
+		return false
+	}
+
+	v.token = &t
+	v.baseURL = v.baseURLs[0]
+	if err := v.installation(ctx, username); err != nil {
+		v.token = nil
+		return false
+	}
+
+	return true
+}
+
+func (v *Verisure) saveToken() error {
+	if v.tokens == nil {
+		return nil
+	}
+	t := v.currentToken()
+	if t == nil {
+		return v.tokens.SaveToken(Token{})
+	}
+
+	return v.tokens.SaveToken(*t)
+}
+
+func isNotFound(err error) bool {
+	se, ok := err.(*statusError)
+	return ok && se.code == http.StatusNotFound
+}
@@ -0,0 +1,140 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// memTokens is a TokenStore kept in memory
+type memTokens struct {
+	mu sync.Mutex
+	t  Token
+}
+
+func (m *memTokens) LoadToken() (Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.t, nil
+}
+
+func (m *memTokens) SaveToken(t Token) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.t = t
+	return nil
+}
+
+// tokenAPI is a fake API only offering bearer token login, with the auth
+// service under /auth
+func tokenAPI(routes map[string]http.HandlerFunc) *httptest.Server {
+	all := map[string]http.HandlerFunc{
+		"POST /cookie":     http.NotFound,
+		"POST /auth/login": ok(`{"accessToken":"access","refreshToken":"refresh","accessTokenMaxAgeSeconds":3600}`),
+	}
+	for k, h := range routes {
+		all[k] = h
+	}
+	return newAPI(all)
+}
+
+func TestTokenOnlySentToOwnHosts(t *testing.T) {
+	var attachment string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attachment = r.Header.Get("Authorization")
+	}))
+	defer other.Close()
+
+	var overview string
+	s := tokenAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+			overview = r.Header.Get("Authorization")
+			w.Write([]byte(`{}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s, WithAuthURL(s.URL+"/auth"))
+	ctx := context.Background()
+	if _, err := v.Overview(ctx); err != nil {
+		t.Fatal(err)
+	}
+	rc, err := v.EventAttachment(ctx, Event{AttachmentURL: other.URL + "/image.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc.Close()
+
+	if overview != "Bearer access" {
+		t.Errorf("overview Authorization %q", overview)
+	}
+	if attachment != "" {
+		t.Errorf("attachment Authorization %q", attachment)
+	}
+}
+
+func TestRefreshTokenOnce(t *testing.T) {
+	var refreshes int32
+	s := tokenAPI(map[string]http.HandlerFunc{
+		"POST /auth/token": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&refreshes, 1)
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte(`{"accessToken":"new","refreshToken":"refresh2","accessTokenMaxAgeSeconds":3600}`))
+		},
+		"GET /installation/1/overview": ok(`{}`),
+	})
+	defer s.Close()
+
+	store := &memTokens{}
+	v := login(t, s, WithAuthURL(s.URL+"/auth"), WithTokenStore(store))
+	v.token.Expiry = time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Overview(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("%d refreshes, want 1", n)
+	}
+	if got, _ := store.LoadToken(); got.AccessToken != "new" {
+		t.Errorf("stored token %+v", got)
+	}
+}
+
+func TestTokenWithoutMaxAge(t *testing.T) {
+	refreshed := false
+	s := tokenAPI(map[string]http.HandlerFunc{
+		"POST /auth/login": ok(`{"accessToken":"access","refreshToken":"refresh"}`),
+		"POST /auth/token": func(w http.ResponseWriter, r *http.Request) {
+			refreshed = true
+			http.Error(w, "", http.StatusUnauthorized)
+		},
+		"GET /installation/1/overview": ok(`{}`),
+	})
+	defer s.Close()
+
+	v := login(t, s, WithAuthURL(s.URL+"/auth"))
+	for i := 0; i < 2; i++ {
+		if _, err := v.Overview(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if refreshed {
+		t.Error("token without a lifetime was refreshed")
+	}
+	if exp, ok := v.SessionValidUntil(); !ok || !exp.IsZero() {
+		t.Errorf("SessionValidUntil = %v, %v", exp, ok)
+	}
+}
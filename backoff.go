@@ -0,0 +1,62 @@
+package verisure
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// maxJitter is the largest jitter fraction, so that a delay never drops
+// below half its base
+const maxJitter = 0.5
+
+// WithBackoffJitter sets how much of each retry and poll delay is
+// randomized, from 0 (fixed delays) to 0.5 (the default). A delay d then
+// lasts between (1-fraction)*d and d, which keeps clients recovering from
+// the same outage from retrying in lockstep. Larger fractions are taken as
+// 0.5.
+func WithBackoffJitter(fraction float64) Option {
+	return func(v *Verisure) {
+		if fraction < 0 {
+			fraction = 0
+		}
+		if fraction > maxJitter {
+			fraction = maxJitter
+		}
+		v.jitterFraction = fraction
+	}
+}
+
+// jitter randomizes d according to the configured fraction
+func (v *Verisure) jitter(d time.Duration) time.Duration {
+	return d - time.Duration(v.shared().rand.Float64()*v.jitterFraction*float64(d))
+}
+
+// lockedRand is a random source of the client's own, safe for concurrent
+// use, so jitter does not depend on how the global source is seeded
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func newLockedRand() *lockedRand {
+	return &lockedRand{r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (l *lockedRand) Float64() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Float64()
+}
+
+// backoff doubles base for every failed attempt, up to max
+func backoff(base time.Duration, attempt int, max time.Duration) time.Duration {
+	d := base
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
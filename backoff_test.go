@@ -0,0 +1,49 @@
+package verisure
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestJitterBounds(t *testing.T) {
+	const d = time.Second
+	for _, fraction := range []float64{0, 0.25, 0.5, 1, 2} {
+		v := New(WithBackoffJitter(fraction))
+		min := d - time.Duration(v.jitterFraction*float64(d))
+		if min < d/2 {
+			t.Errorf("fraction %v: delays may drop to %v", fraction, min)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 250; j++ {
+					if got := v.jitter(d); got < min || got > d {
+						t.Errorf("fraction %v: delay %v outside [%v, %v]", fraction, got, min, d)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{10, 30 * time.Second},
+	} {
+		if got := backoff(time.Second, tc.attempt, 30*time.Second); got != tc.want {
+			t.Errorf("attempt %d: got %v, want %v", tc.attempt, got, tc.want)
+		}
+	}
+}
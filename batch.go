@@ -0,0 +1,45 @@
+package verisure
+
+import "context"
+
+// BatchResult maps each device label of a batch to the error of its
+// operation, nil on success
+type BatchResult map[string]error
+
+// Failed returns the labels whose operation failed
+func (r BatchResult) Failed() []string {
+	var labels []string
+	for label, err := range r {
+		if err != nil {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// UpdateSmartplugsBatch switches each smart plug separately so that one
+// failing plug does not stop the others. Labels that are not smart plugs
+// give ErrUnknownDevice. The error is only set if the batch could not be
+// started at all.
+func (v *Verisure) UpdateSmartplugsBatch(ctx context.Context, updates []SmartPlugState) (BatchResult, error) {
+	o, err := v.Overview(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(o.SmartPlugs))
+	for _, p := range o.SmartPlugs {
+		known[p.DeviceLabel] = true
+	}
+
+	r := make(BatchResult, len(updates))
+	for _, u := range updates {
+		if !known[u.DeviceLabel] {
+			r[u.DeviceLabel] = ErrUnknownDevice
+			continue
+		}
+		r[u.DeviceLabel] = v.UpdateSmartplug(ctx, []SmartPlugState{u})
+	}
+
+	return r, nil
+}
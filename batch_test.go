@@ -0,0 +1,50 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+func TestUpdateSmartplugsBatch(t *testing.T) {
+	switched := map[string]bool{}
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(`{"smartPlugs":[{"deviceLabel":"SP1"},{"deviceLabel":"SP2"}]}`),
+		"POST /installation/1/smartplug/state": func(w http.ResponseWriter, r *http.Request) {
+			var us []SmartPlugState
+			if err := json.NewDecoder(r.Body).Decode(&us); err != nil || len(us) != 1 {
+				t.Errorf("body %v, %v", us, err)
+			}
+			if us[0].DeviceLabel == "SP2" {
+				reply(http.StatusBadRequest, `{"errorCode":"DEVICE_OFFLINE"}`)(w, r)
+				return
+			}
+			switched[us[0].DeviceLabel] = us[0].State
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	r, err := v.UpdateSmartplugsBatch(context.Background(), []SmartPlugState{
+		{DeviceLabel: "SP1", State: true},
+		{DeviceLabel: "SP2", State: true},
+		{DeviceLabel: "SP3", State: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r) != 3 || r["SP1"] != nil || r["SP2"] == nil || r["SP3"] != ErrUnknownDevice {
+		t.Errorf("result %v", r)
+	}
+	failed := r.Failed()
+	sort.Strings(failed)
+	if len(failed) != 2 || failed[0] != "SP2" || failed[1] != "SP3" {
+		t.Errorf("failed %v", failed)
+	}
+	if len(switched) != 1 || !switched["SP1"] {
+		t.Errorf("switched %v", switched)
+	}
+}
@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// BatteryReplacement is a device in the battery replacement flow. Status
+// is the step it has reached, such as "WAITING" or "REPLACED".
+type BatteryReplacement struct {
+	DeviceLabel string `json:"deviceLabel"`
+	DeviceType  string `json:"deviceType"`
+	Area        string `json:"area"`
+	Status      string `json:"status"`
+}
+
+// BatteryProcessDetail lists the devices whose batteries are being
+// replaced
+type BatteryProcessDetail struct {
+	Active  bool                 `json:"active"`
+	Devices []BatteryReplacement `json:"devices"`
+}
+
+// BatteryProcessDetail details Overview.BatteryProcess. The detail is empty
+// when no battery replacement is in progress.
+func (v *Verisure) BatteryProcessDetail(ctx context.Context) (BatteryProcessDetail, error) {
+	d := BatteryProcessDetail{Devices: []BatteryReplacement{}}
+	o, err := v.Overview(ctx)
+	if err != nil || !o.BatteryProcess.Active {
+		return d, err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return d, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/batteryprocess", giid)
+	if err := v.call(ctx, "battery process", http.MethodGet, path, nil, &d); err != nil {
+		return d, err
+	}
+	if d.Devices == nil {
+		d.Devices = []BatteryReplacement{}
+	}
+
+	return d, nil
+}
@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type bypassCommand struct {
+	Code   string `json:"code"`
+	Bypass bool   `json:"bypass"`
+}
+
+type bypassTransaction struct {
+	ID string `json:"transactionId"`
+}
+
+// BypassDevice excludes a sensor from arming, or includes it again, and
+// waits for the panel to confirm. Devices that cannot be bypassed give
+// ErrNotSupported. Device.Bypassed reflects the current state.
+func (v *Verisure) BypassDevice(ctx context.Context, deviceLabel, code string, bypass bool) error {
+	if err := v.checkCode(code); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	d, err := v.device(ctx, deviceLabel)
+	if err != nil {
+		return err
+	}
+	if !d.Bypassable {
+		return ErrNotSupported
+	}
+
+	var t bypassTransaction
+	path := fmt.Sprintf("/installation/%s/device/%s/bypass", giid, url.PathEscape(deviceLabel))
+	if err := v.call(ctx, "bypass", http.MethodPut, path, bypassCommand{code, bypass}, &t); err != nil {
+		return err
+	}
+
+	path = fmt.Sprintf("/installation/%s/device/bypass/result/%s", giid, t.ID)
+	return v.waitTransaction(ctx, "bypass", path)
+}
@@ -0,0 +1,133 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// CameraImage captured by a customer image camera
+type CameraImage struct {
+	ImageID     string    `json:"imageId"`
+	DeviceLabel string    `json:"deviceLabel"`
+	CaptureTime time.Time `json:"captureTime"`
+}
+
+type imageSeriesSearch struct {
+	ImageSeries []struct {
+		Image []CameraImage `json:"image"`
+	} `json:"imageSeries"`
+}
+
+// LatestImage streams the most recent image stored for a camera along
+// with its capture time. The caller must close the reader. Cameras without
+// images give ErrNoImages.
+func (v *Verisure) LatestImage(ctx context.Context, deviceLabel string) (io.ReadCloser, time.Time, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	q := url.Values{}
+	q.Set("deviceLabel", deviceLabel)
+	q.Set("numberOfImageSeries", "1")
+	q.Set("offset", "0")
+
+	var s imageSeriesSearch
+	path := fmt.Sprintf("/installation/%s/device/customerimagecamera/imageseries/search?%s", giid, q.Encode())
+	if err := v.call(ctx, "image series", http.MethodGet, path, nil, &s); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var latest *CameraImage
+	for _, series := range s.ImageSeries {
+		for i := range series.Image {
+			if latest == nil || series.Image[i].CaptureTime.After(latest.CaptureTime) {
+				latest = &series.Image[i]
+			}
+		}
+	}
+	if latest == nil {
+		return nil, time.Time{}, ErrNoImages
+	}
+
+	u := fmt.Sprintf("%s/installation/%s/device/%s/customerimagecamera/image/%s/",
+		v.host(), giid, url.PathEscape(deviceLabel), url.PathEscape(latest.ImageID))
+	r, err := v.stream(ctx, "image", u)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return r, latest.CaptureTime, nil
+}
+
+type imageCapture struct {
+	ImageID string `json:"imageId"`
+}
+
+// CaptureError reports the cameras CaptureAll failed to trigger
+type CaptureError struct {
+	Errors BatchResult
+}
+
+func (e *CaptureError) Error() string {
+	return fmt.Sprintf("capture: %d cameras failed", len(e.Errors))
+}
+
+// CaptureAll triggers a capture on every customer image camera at once and returns
+// the new image ID by device label. Cameras that fail are left out of the
+// map and reported in a *CaptureError.
+func (v *Verisure) CaptureAll(ctx context.Context) (map[string]string, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := v.Overview(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var cameras []struct {
+		DeviceLabel string `json:"deviceLabel"`
+	}
+	if len(o.CustomerImageCameras) > 0 {
+		if err := o.DecodeSection("customerImageCameras", &cameras); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		ids    = make(map[string]string, len(cameras))
+		failed = BatchResult{}
+	)
+	for _, c := range cameras {
+		wg.Add(1)
+		go func(label string) {
+			defer wg.Done()
+
+			var r imageCapture
+			path := fmt.Sprintf("/installation/%s/device/%s/customerimagecamera/imagecapture", giid, url.PathEscape(label))
+			err := v.call(ctx, "capture", http.MethodPost, path, nil, &r)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[label] = err
+				return
+			}
+			ids[label] = r.ImageID
+		}(c.DeviceLabel)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return ids, &CaptureError{failed}
+	}
+	return ids, nil
+}
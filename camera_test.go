@@ -0,0 +1,32 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCaptureAll(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(`{
+			"customerImageCameras": [{"deviceLabel":"CAM1"},{"deviceLabel":"CAM2"}],
+			"smartCameras": [{"deviceLabel":"SMART"}]
+		}`),
+		"POST /installation/1/device/CAM1/customerimagecamera/imagecapture": ok(`{"imageId":"img1"}`),
+		"POST /installation/1/device/CAM2/customerimagecamera/imagecapture": reply(http.StatusBadRequest, `{"errorCode":"OFFLINE"}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ids, err := v.CaptureAll(context.Background())
+	ce, ok := err.(*CaptureError)
+	if !ok {
+		t.Fatalf("error %v, want *CaptureError", err)
+	}
+	if len(ids) != 1 || ids["CAM1"] != "img1" {
+		t.Errorf("ids %v", ids)
+	}
+	if len(ce.Errors) != 1 || ce.Errors["CAM2"] == nil {
+		t.Errorf("failures %v", ce.Errors)
+	}
+}
@@ -0,0 +1,97 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CameraCapabilities of a camera or doorbell
+type CameraCapabilities struct {
+	TwoWayAudio   bool `json:"twoWayAudioSupported"`
+	NightVision   bool `json:"nightVisionSupported"`
+	LiveStreaming bool `json:"liveStreamSupported"`
+}
+
+// CameraCapabilities reports which features a camera supports. Devices
+// that are not cameras give ErrNotSupported.
+func (v *Verisure) CameraCapabilities(ctx context.Context, deviceLabel string) (CameraCapabilities, error) {
+	var c CameraCapabilities
+	giid, err := v.giid()
+	if err != nil {
+		return c, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/device/%s/camera/config", giid, url.PathEscape(deviceLabel))
+	if err := v.call(ctx, "camera capabilities", http.MethodGet, path, nil, &c); err != nil {
+		if isNotFound(err) {
+			return c, ErrNotSupported
+		}
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Stream protocols
+const (
+	StreamRTSP = "RTSP"
+	StreamHLS  = "HLS"
+)
+
+// StreamInfo is a short-lived live stream of a camera. The URL stops
+// working at Expiry; get a new one with RefreshCameraStream.
+type StreamInfo struct {
+	DeviceLabel string
+	URL         string
+	Protocol    string
+	Token       string
+	Expiry      time.Time
+}
+
+// Expired reports whether the stream URL has expired
+func (s StreamInfo) Expired() bool {
+	return !time.Now().Before(s.Expiry)
+}
+
+type streamJSON struct {
+	URL       string `json:"url"`
+	Protocol  string `json:"protocol"`
+	Token     string `json:"token"`
+	ExpiresIn int    `json:"expiresInSeconds"`
+}
+
+// CameraStream returns a live stream URL for a camera. Cameras without
+// live streaming give ErrNotSupported.
+func (v *Verisure) CameraStream(ctx context.Context, deviceLabel string) (StreamInfo, error) {
+	s := StreamInfo{DeviceLabel: deviceLabel}
+	c, err := v.CameraCapabilities(ctx, deviceLabel)
+	if err != nil {
+		return s, err
+	}
+	if !c.LiveStreaming {
+		return s, ErrNotSupported
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return s, err
+	}
+
+	var j streamJSON
+	path := fmt.Sprintf("/installation/%s/device/%s/camera/stream", giid, url.PathEscape(deviceLabel))
+	if err := v.call(ctx, "camera stream", http.MethodPost, path, nil, &j); err != nil {
+		return s, err
+	}
+
+	s.URL, s.Protocol, s.Token = j.URL, j.Protocol, j.Token
+	s.Expiry = time.Now().Add(time.Duration(j.ExpiresIn) * time.Second)
+	return s, nil
+}
+
+// RefreshCameraStream replaces an expiring stream with a new one
+func (v *Verisure) RefreshCameraStream(ctx context.Context, s StreamInfo) (StreamInfo, error) {
+	return v.CameraStream(ctx, s.DeviceLabel)
+}
@@ -0,0 +1,87 @@
+package verisure
+
+import (
+	"context"
+	"time"
+)
+
+// Client is the core of the API implemented by *Verisure, for decorating
+// or substituting the client
+type Client interface {
+	Login(ctx context.Context, username, password string) error
+	Logout(ctx context.Context) error
+	Overview(ctx context.Context) (Overview, error)
+	UpdateSmartplug(ctx context.Context, updates []SmartPlugState) error
+	SetArmState(ctx context.Context, code string, state ArmStatusType) error
+	SetDoorLock(ctx context.Context, deviceLabel, code string, lock bool) error
+	Events(ctx context.Context, opts EventOptions) ([]Event, error)
+	Devices(ctx context.Context) ([]Device, error)
+}
+
+var _ Client = (*Verisure)(nil)
+
+// WithAutoTimeout wraps c so that calls whose context has no deadline get
+// one d from now. Contexts that already have a deadline are passed as is.
+func WithAutoTimeout(c Client, d time.Duration) Client {
+	return autoTimeout{c: c, d: d}
+}
+
+type autoTimeout struct {
+	c Client
+	d time.Duration
+}
+
+func (a autoTimeout) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, a.d)
+}
+
+func (a autoTimeout) Login(ctx context.Context, username, password string) error {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.Login(ctx, username, password)
+}
+
+func (a autoTimeout) Logout(ctx context.Context) error {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.Logout(ctx)
+}
+
+func (a autoTimeout) Overview(ctx context.Context) (Overview, error) {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.Overview(ctx)
+}
+
+func (a autoTimeout) UpdateSmartplug(ctx context.Context, updates []SmartPlugState) error {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.UpdateSmartplug(ctx, updates)
+}
+
+func (a autoTimeout) SetArmState(ctx context.Context, code string, state ArmStatusType) error {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.SetArmState(ctx, code, state)
+}
+
+func (a autoTimeout) SetDoorLock(ctx context.Context, deviceLabel, code string, lock bool) error {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.SetDoorLock(ctx, deviceLabel, code, lock)
+}
+
+func (a autoTimeout) Events(ctx context.Context, opts EventOptions) ([]Event, error) {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.Events(ctx, opts)
+}
+
+func (a autoTimeout) Devices(ctx context.Context) ([]Device, error) {
+	ctx, cancel := a.context(ctx)
+	defer cancel()
+	return a.c.Devices(ctx)
+}
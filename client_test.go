@@ -0,0 +1,42 @@
+package verisure
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// deadlineClient records the deadline of the context Overview gets
+type deadlineClient struct {
+	Client
+	deadline time.Time
+	ok       bool
+}
+
+func (c *deadlineClient) Overview(ctx context.Context) (Overview, error) {
+	c.deadline, c.ok = ctx.Deadline()
+	return Overview{}, nil
+}
+
+func TestWithAutoTimeout(t *testing.T) {
+	c := &deadlineClient{}
+	wrapped := WithAutoTimeout(c, time.Minute)
+
+	start := time.Now()
+	if _, err := wrapped.Overview(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !c.ok || c.deadline.Before(start.Add(time.Minute)) || c.deadline.After(time.Now().Add(time.Minute)) {
+		t.Errorf("background context got deadline %v, %v", c.deadline, c.ok)
+	}
+
+	want := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.Background(), want)
+	defer cancel()
+	if _, err := wrapped.Overview(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !c.ok || !c.deadline.Equal(want) {
+		t.Errorf("deadlined context got %v, want %v", c.deadline, want)
+	}
+}
@@ -0,0 +1,51 @@
+package verisure
+
+import "sync"
+
+// WithClimateHistory makes Watch keep the last n climate readings of each
+// sensor in memory, available through RecentClimate
+func WithClimateHistory(n int) Option {
+	return func(v *Verisure) {
+		if n <= 0 {
+			v.climate = nil
+			return
+		}
+		v.climate = &climateRing{size: n, readings: make(map[string][]ClimateValue)}
+	}
+}
+
+// climateRing holds up to size readings per sensor, oldest first
+type climateRing struct {
+	mu       sync.Mutex
+	size     int
+	readings map[string][]ClimateValue
+}
+
+// add records the values that are newer than the sensor's last reading
+func (r *climateRing) add(cs []ClimateValue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range cs {
+		rs := r.readings[c.DeviceLabel]
+		if n := len(rs); n > 0 && !c.Time.After(rs[n-1].Time) {
+			continue
+		}
+		if len(rs) == r.size {
+			rs = append(rs[:0], rs[1:]...)
+		}
+		r.readings[c.DeviceLabel] = append(rs, c)
+	}
+}
+
+// RecentClimate returns the readings of a sensor recorded by Watch, oldest
+// first. It is empty unless the client was created WithClimateHistory.
+func (v *Verisure) RecentClimate(deviceLabel string) []ClimateValue {
+	if v.climate == nil {
+		return nil
+	}
+
+	v.climate.mu.Lock()
+	defer v.climate.mu.Unlock()
+	return append([]ClimateValue(nil), v.climate.readings[deviceLabel]...)
+}
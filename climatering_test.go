@@ -0,0 +1,69 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRecentClimateConcurrent(t *testing.T) {
+	var polls int64
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt64(&polls, 1)
+			at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(n) * time.Minute)
+			fmt.Fprintf(w, `{"climateValues":[{"deviceLabel":"CL1","temperature":%d,"time":%q}]}`, n, at.Format(time.RFC3339))
+		},
+	})
+	defer s.Close()
+
+	const size = 3
+	v := login(t, s, WithClimateHistory(size))
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, _ := v.Watch(ctx, time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				rs := v.RecentClimate("CL1")
+				if len(rs) > size {
+					t.Errorf("%d readings kept, want at most %d", len(rs), size)
+					return
+				}
+				for k := 1; k < len(rs); k++ {
+					if !rs[k].Time.After(rs[k-1].Time) {
+						t.Errorf("readings out of order: %+v", rs)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for n := 0; n < 2*size; n++ {
+		<-updates
+	}
+	wg.Wait()
+	cancel()
+	for range updates {
+	}
+
+	rs := v.RecentClimate("CL1")
+	if len(rs) != size {
+		t.Fatalf("%d readings, want %d", len(rs), size)
+	}
+	if last := rs[size-1].Temperature; last < 2*size {
+		t.Errorf("latest reading %v is stale", last)
+	}
+	plain := New()
+	if v.RecentClimate("unknown") != nil || plain.RecentClimate("CL1") != nil {
+		t.Error("readings for an unknown sensor or without history")
+	}
+}
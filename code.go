@@ -0,0 +1,61 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WithCodeLength makes commands reject PIN codes that are not exactly n
+// digits before contacting the API. By default any non-empty numeric code
+// is accepted.
+func WithCodeLength(n int) Option {
+	return func(v *Verisure) {
+		v.codeLength = n
+	}
+}
+
+// checkCode validates a PIN code client-side, so malformed codes neither
+// cost a round trip nor count towards the panel's lockout
+func (v *Verisure) checkCode(code string) error {
+	if code == "" {
+		return ErrCodeRequired
+	}
+	if v.codeLength > 0 && len(code) != v.codeLength {
+		return ErrInvalidCodeFormat
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return ErrInvalidCodeFormat
+		}
+	}
+
+	return nil
+}
+
+type changeCodeCommand struct {
+	OldCode string `json:"oldCode"`
+	NewCode string `json:"newCode"`
+}
+
+// ChangeMyCode changes the logged in user's PIN code. The old code
+// authorizes the change; ErrWrongCode is returned if it is not accepted.
+func (v *Verisure) ChangeMyCode(ctx context.Context, oldCode, newCode string) error {
+	if err := v.checkCode(oldCode); err != nil {
+		return err
+	}
+	if err := v.checkCode(newCode); err != nil {
+		return err
+	}
+	if oldCode == newCode {
+		return fmt.Errorf("change code: new code must differ from the old one")
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/code", giid)
+	return v.call(ctx, "change code", http.MethodPut, path, changeCodeCommand{oldCode, newCode}, nil)
+}
@@ -0,0 +1,66 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Communication test step results
+const (
+	CommTestPending = "PENDING"
+	CommTestPassed  = "PASSED"
+	CommTestFailed  = "FAILED"
+)
+
+// CommTestResult of the panel's communication self-test. Steps that have
+// not finished are CommTestPending.
+type CommTestResult struct {
+	Ethernet  string    `json:"ethernetResult"`
+	GSM       string    `json:"gsmResult"`
+	Completed bool      `json:"completed"`
+	Time      time.Time `json:"testDate"`
+}
+
+type commTestTransaction struct {
+	ID string `json:"transactionId"`
+}
+
+// RunCommunicationTest starts the ethernet and GSM self-test and polls
+// until both have finished. The test can take minutes; if ctx ends first
+// the partial result is returned along with ctx's error.
+func (v *Verisure) RunCommunicationTest(ctx context.Context) (CommTestResult, error) {
+	r := CommTestResult{Ethernet: CommTestPending, GSM: CommTestPending}
+	giid, err := v.giid()
+	if err != nil {
+		return r, err
+	}
+
+	var t commTestTransaction
+	path := fmt.Sprintf("/installation/%s/communicationtest", giid)
+	if err := v.call(ctx, "communication test", http.MethodPost, path, nil, &t); err != nil {
+		return r, err
+	}
+
+	path = fmt.Sprintf("/installation/%s/communicationtest/result/%s", giid, t.ID)
+	for {
+		var next CommTestResult
+		if err := v.call(ctx, "communication test", http.MethodGet, path, nil, &next); err != nil {
+			if ctx.Err() != nil {
+				return r, ctx.Err()
+			}
+			return r, err
+		}
+		r = next
+		if r.Completed {
+			return r, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return r, ctx.Err()
+		case <-time.After(v.jitter(pollInterval)):
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package verisure
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Config holds every client setting in one struct that can be loaded from
+// JSON or the environment. Zero values keep New's defaults. Username and
+// Password are not used by NewFromConfig; pass them to Login.
+type Config struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	BaseURLs  []string `json:"baseUrls"`
+	AuthURL   string   `json:"authUrl"`
+	Shard     *int     `json:"shard"`
+	Locale    string   `json:"locale"`
+	GIID      string   `json:"giid"`
+	UserAgent string   `json:"userAgent"`
+
+	Timeout          Duration `json:"timeout"`
+	MaxResponseBytes int64    `json:"maxResponseBytes"`
+	CodeLength       int      `json:"codeLength"`
+	MaxFailover      *int     `json:"maxFailover"`
+	RateLimit        float64  `json:"rateLimit"`
+	RateBurst        int      `json:"rateBurst"`
+
+	MaxIdleConns        int      `json:"maxIdleConns"`
+	MaxIdleConnsPerHost int      `json:"maxIdleConnsPerHost"`
+	MaxConnsPerHost     int      `json:"maxConnsPerHost"`
+	IdleTimeout         Duration `json:"idleTimeout"`
+
+	HTTPClient *http.Client `json:"-"`
+}
+
+// Duration is a time.Duration that is written to JSON as a string such as
+// "10s" and read from either that or a number of nanoseconds
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n int64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return errors.New("verisure: duration must be a string like \"10s\" or a number of nanoseconds")
+		}
+		*d = Duration(n)
+		return nil
+	}
+
+	t, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(t)
+	return nil
+}
+
+func (c Config) tuned() bool {
+	return c.MaxIdleConns != 0 || c.MaxIdleConnsPerHost != 0 || c.MaxConnsPerHost != 0 || c.IdleTimeout != 0
+}
+
+// Validate reports contradictory or out of range settings
+func (c Config) Validate() error {
+	switch {
+	case c.HTTPClient != nil && c.tuned():
+		return errClientAndTuning
+	case c.HTTPClient != nil && c.Timeout != 0:
+		return errors.New("verisure: set the timeout on HTTPClient instead")
+	case len(c.BaseURLs) > 0 && c.Shard != nil:
+		return errors.New("verisure: BaseURLs and Shard are mutually exclusive")
+	case c.Shard != nil && (*c.Shard < 0 || *c.Shard >= len(apiURLs)):
+		return errors.New("verisure: unknown shard")
+	case c.Locale != "" && c.GIID == "":
+		return errors.New("verisure: Locale requires GIID")
+	case c.RateLimit < 0 || c.RateBurst < 0:
+		return errors.New("verisure: negative rate limit")
+	case c.RateBurst > 0 && c.RateLimit == 0:
+		return errors.New("verisure: RateBurst requires RateLimit")
+	case c.Timeout < 0 || c.MaxResponseBytes < 0 || c.CodeLength < 0 ||
+		c.MaxIdleConns < 0 || c.MaxIdleConnsPerHost < 0 || c.MaxConnsPerHost < 0 || c.IdleTimeout < 0:
+		return errors.New("verisure: negative limit")
+	}
+	return nil
+}
+
+// NewFromConfig validates cfg and creates a client with the corresponding
+// options
+func NewFromConfig(cfg Config) (*Verisure, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []Option
+	if len(cfg.BaseURLs) > 0 {
+		opts = append(opts, WithBaseURLs(cfg.BaseURLs...))
+	}
+	if cfg.Shard != nil {
+		opts = append(opts, WithShard(*cfg.Shard))
+	}
+	if cfg.AuthURL != "" {
+		opts = append(opts, WithAuthURL(cfg.AuthURL))
+	}
+	if cfg.GIID != "" {
+		opts = append(opts, WithSkipInstallationLookup(cfg.GIID), WithLocale(cfg.Locale))
+	}
+	if cfg.UserAgent != "" {
+		opts = append(opts, WithUserAgent(cfg.UserAgent))
+	}
+	if cfg.MaxResponseBytes > 0 {
+		opts = append(opts, WithMaxResponseBytes(cfg.MaxResponseBytes))
+	}
+	if cfg.CodeLength > 0 {
+		opts = append(opts, WithCodeLength(cfg.CodeLength))
+	}
+	if cfg.MaxFailover != nil {
+		opts = append(opts, WithMaxFailover(*cfg.MaxFailover))
+	}
+	if cfg.RateLimit > 0 {
+		opts = append(opts, WithRateLimit(cfg.RateLimit, cfg.RateBurst))
+	}
+	if cfg.HTTPClient != nil {
+		opts = append(opts, WithHTTPClient(cfg.HTTPClient))
+	}
+	if cfg.tuned() {
+		opts = append(opts, WithTransportTuning(cfg.MaxIdleConns, cfg.MaxIdleConnsPerHost, cfg.MaxConnsPerHost, time.Duration(cfg.IdleTimeout)))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, func(v *Verisure) { v.client.Timeout = time.Duration(cfg.Timeout) })
+	}
+
+	v := New(opts...)
+	if v.err != nil {
+		return nil, v.err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,70 @@
+package verisure
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestConfigJSON(t *testing.T) {
+	var c Config
+	err := json.Unmarshal([]byte(`{"shard":1,"timeout":"10s","idleTimeout":45000000000,"maxIdleConns":2}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Timeout != Duration(10*time.Second) || c.IdleTimeout != Duration(45*time.Second) {
+		t.Errorf("timeouts %v, %v", c.Timeout, c.IdleTimeout)
+	}
+
+	v, err := NewFromConfig(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.client.Timeout != 10*time.Second || v.baseURLs[0] != apiURLs[1] {
+		t.Errorf("client timeout %v, base URLs %v", v.client.Timeout, v.baseURLs)
+	}
+	if tr := v.transport(); tr.IdleConnTimeout != 45*time.Second || tr.MaxIdleConns != 2 {
+		t.Errorf("transport %+v", tr)
+	}
+
+	bs, err := json.Marshal(Config{Timeout: Duration(1500 * time.Millisecond)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back Config
+	if err := json.Unmarshal(bs, &back); err != nil || back.Timeout != Duration(1500*time.Millisecond) {
+		t.Errorf("round trip %s: %v, %v", bs, back.Timeout, err)
+	}
+
+	for _, bad := range []string{`{"timeout":"10 parsecs"}`, `{"timeout":true}`} {
+		if err := json.Unmarshal([]byte(bad), &c); err == nil {
+			t.Errorf("%s: no error", bad)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	one, ten := 1, 10
+	for _, c := range []Config{
+		{HTTPClient: &http.Client{}, MaxIdleConns: 2},
+		{HTTPClient: &http.Client{}, Timeout: Duration(time.Second)},
+		{BaseURLs: []string{"https://example.com"}, Shard: &one},
+		{Shard: &ten},
+		{Locale: "sv_SE"},
+		{RateLimit: -1},
+		{RateBurst: 3},
+		{Timeout: Duration(-time.Second)},
+		{IdleTimeout: Duration(-time.Second)},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("%+v: no error", c)
+		}
+		if _, err := NewFromConfig(c); err == nil {
+			t.Errorf("%+v: NewFromConfig succeeded", c)
+		}
+	}
+	if err := (Config{}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,34 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// Contact called by the monitoring center on alarm. Phone is masked unless
+// the user may manage contacts.
+type Contact struct {
+	Name      string `json:"name"`
+	Phone     string `json:"phoneNumber"`
+	CallOrder int    `json:"callOrder"`
+}
+
+// ContactList returns the installation's contacts in the order they are
+// called. Only owners may read it, others get ErrPermissionDenied.
+func (v *Verisure) ContactList(ctx context.Context) ([]Contact, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []Contact
+	path := fmt.Sprintf("/installation/%s/contacts", giid)
+	if err := v.call(ctx, "contacts", http.MethodGet, path, nil, &cs); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(cs, func(i, j int) bool { return cs[i].CallOrder < cs[j].CallOrder })
+
+	return cs, nil
+}
@@ -0,0 +1,159 @@
+package verisure
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Device installed in the installation
+type Device struct {
+	DeviceLabel     string    `json:"deviceLabel"`
+	DeviceType      string    `json:"deviceType"`
+	Area            string    `json:"area"`
+	Battery         string    `json:"battery"`
+	FirmwareVersion string    `json:"firmwareVersion"`
+	FirmwareUpdate  bool      `json:"firmwareUpdateAvailable"`
+	Bypassable      bool      `json:"bypassAllowed"`
+	Bypassed        bool      `json:"bypassed"`
+	Alarm           bool      `json:"alarm"`
+	Online          bool      `json:"online"`
+	Tamper          bool      `json:"tamper"`
+	TamperTime      time.Time `json:"tamperTime"`
+	LastUsed        time.Time `json:"lastUsed"`
+}
+
+// UnmarshalJSON takes devices that do not report whether they are online
+// to be online
+func (d *Device) UnmarshalJSON(data []byte) error {
+	type device Device
+	aux := struct {
+		*device
+		Online *bool `json:"online"`
+	}{device: (*device)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d.Online = aux.Online == nil || *aux.Online
+
+	return nil
+}
+
+// Device types, smoke detectors carry a model suffix such as SMOKE2
+const (
+	DeviceTypeSmoke  = "SMOKE"
+	DeviceTypeHeat   = "HEAT"
+	DeviceTypeKeypad = "KEYPAD"
+	DeviceTypeTag    = "TAG"
+)
+
+// ExportFormat of an inventory export
+type ExportFormat int
+
+// Supported export formats
+const (
+	ExportCSV ExportFormat = iota
+	ExportJSON
+)
+
+// Devices ...
+func (v *Verisure) Devices(ctx context.Context) ([]Device, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var ds []Device
+	path := fmt.Sprintf("/installation/%s/device", giid)
+	if err := v.call(ctx, "devices", http.MethodGet, path, nil, &ds); err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+// device looks up a single device by label
+func (v *Verisure) device(ctx context.Context, deviceLabel string) (Device, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return Device{}, err
+	}
+	for _, d := range ds {
+		if d.DeviceLabel == deviceLabel {
+			return d, nil
+		}
+	}
+
+	return Device{}, ErrUnknownDevice
+}
+
+// UniqueID identifies the device by type, area and label, which together
+// are unique even where labels repeat. It only depends on those fields, so
+// it stays the same across fetches and can be used as an entity ID.
+func (d Device) UniqueID() string {
+	return url.PathEscape(d.DeviceType) + "/" + url.PathEscape(d.Area) + "/" + url.PathEscape(d.DeviceLabel)
+}
+
+// ExportInventory writes every device as CSV or JSON to w. The device list
+// is fetched and decoded in full first; only the output is then written a
+// row at a time.
+func (v *Verisure) ExportInventory(ctx context.Context, w io.Writer, format ExportFormat) error {
+	if format != ExportCSV && format != ExportJSON {
+		return fmt.Errorf("export: unknown format %d", format)
+	}
+
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if format == ExportJSON {
+		return writeInventoryJSON(w, ds)
+	}
+
+	return writeInventoryCSV(w, ds)
+}
+
+func writeInventoryCSV(w io.Writer, ds []Device) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"label", "type", "area", "battery", "firmware"}); err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if err := cw.Write([]string{d.DeviceLabel, d.DeviceType, d.Area, d.Battery, d.FirmwareVersion}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func writeInventoryJSON(w io.Writer, ds []Device) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	for i, d := range ds {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		bs, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(bs); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]\n")
+
+	return err
+}
@@ -0,0 +1,47 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDeviceUniqueID(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/device": sequence(
+			ok(`[{"deviceLabel":"A","area":"Hall","deviceType":"SMOKE2","battery":"OK"},
+				{"deviceLabel":"A","area":"Kitchen","deviceType":"SMOKE2"},
+				{"deviceLabel":"A","area":"Hall","deviceType":"PIR"},
+				{"deviceLabel":"B/C","area":"Hall/Upstairs","deviceType":"PIR"},
+				{"deviceLabel":"B","area":"C/Hall/Upstairs","deviceType":"PIR"}]`),
+			ok(`[{"deviceLabel":"B/C","area":"Hall/Upstairs","deviceType":"PIR","tamper":true},
+				{"deviceLabel":"B","area":"C/Hall/Upstairs","deviceType":"PIR"},
+				{"deviceLabel":"A","area":"Hall","deviceType":"PIR"},
+				{"deviceLabel":"A","area":"Kitchen","deviceType":"SMOKE2"},
+				{"deviceLabel":"A","area":"Hall","deviceType":"SMOKE2","battery":"LOW"}]`)),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ids := func() map[string]Device {
+		ds, err := v.Devices(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := make(map[string]Device, len(ds))
+		for _, d := range ds {
+			m[d.UniqueID()] = d
+		}
+		if len(m) != len(ds) {
+			t.Errorf("%d IDs for %d devices", len(m), len(ds))
+		}
+		return m
+	}
+
+	first, second := ids(), ids()
+	for id, d := range first {
+		if e, ok := second[id]; !ok || e.DeviceLabel != d.DeviceLabel || e.Area != d.Area || e.DeviceType != d.DeviceType {
+			t.Errorf("%s changed between fetches: %+v, %+v", id, d, e)
+		}
+	}
+}
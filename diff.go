@@ -0,0 +1,62 @@
+package verisure
+
+// OverviewDiff describes what changed between two overview snapshots
+type OverviewDiff struct {
+	ArmStateChanged bool
+	ArmState        ArmState
+	DoorWindows     []DoorWindowDevice
+	SmartPlugs      []SmartPlug
+	ClimateValues   []ClimateValue
+}
+
+// Empty reports whether nothing changed
+func (d OverviewDiff) Empty() bool {
+	return !d.ArmStateChanged &&
+		len(d.DoorWindows) == 0 &&
+		len(d.SmartPlugs) == 0 &&
+		len(d.ClimateValues) == 0
+}
+
+// Diff returns the changes from prev to o. Doors and plugs are reported
+// when their state differs, climate values when a newer reading arrived.
+// Devices missing from prev count as changed.
+func (o Overview) Diff(prev Overview) OverviewDiff {
+	var d OverviewDiff
+
+	if o.ArmState.StatusType != prev.ArmState.StatusType || !o.ArmState.Date.Equal(prev.ArmState.Date) {
+		d.ArmStateChanged = true
+		d.ArmState = o.ArmState
+	}
+
+	doors := make(map[string]string, len(prev.DoorWindow.DoorWindowDevice))
+	for _, dw := range prev.DoorWindow.DoorWindowDevice {
+		doors[dw.DeviceLabel] = dw.State
+	}
+	for _, dw := range o.DoorWindow.DoorWindowDevice {
+		if state, ok := doors[dw.DeviceLabel]; !ok || state != dw.State {
+			d.DoorWindows = append(d.DoorWindows, dw)
+		}
+	}
+
+	plugs := make(map[string]string, len(prev.SmartPlugs))
+	for _, p := range prev.SmartPlugs {
+		plugs[p.DeviceLabel] = p.CurrentState
+	}
+	for _, p := range o.SmartPlugs {
+		if state, ok := plugs[p.DeviceLabel]; !ok || state != p.CurrentState {
+			d.SmartPlugs = append(d.SmartPlugs, p)
+		}
+	}
+
+	climate := make(map[string]ClimateValue, len(prev.ClimateValues))
+	for _, c := range prev.ClimateValues {
+		climate[c.DeviceLabel] = c
+	}
+	for _, c := range o.ClimateValues {
+		if p, ok := climate[c.DeviceLabel]; !ok || c.Time.After(p.Time) {
+			d.ClimateValues = append(d.ClimateValues, c)
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,62 @@
+package verisure
+
+import (
+	"testing"
+	"time"
+)
+
+func diffBase() Overview {
+	t0 := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	var o Overview
+	o.ArmState = ArmState{StatusType: string(ArmDisarmed), Date: t0}
+	o.DoorWindow.DoorWindowDevice = []DoorWindowDevice{{DeviceLabel: "DW1", State: "CLOSE"}}
+	o.SmartPlugs = []SmartPlug{{DeviceLabel: "SP1", CurrentState: "OFF"}}
+	o.ClimateValues = []ClimateValue{{DeviceLabel: "CL1", Temperature: 20, Time: t0}}
+	return o
+}
+
+func TestDiff(t *testing.T) {
+	later := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name   string
+		change func(*Overview)
+		check  func(OverviewDiff) bool
+	}{
+		{"nothing", func(o *Overview) {}, OverviewDiff.Empty},
+		{"arm state", func(o *Overview) {
+			o.ArmState = ArmState{StatusType: string(ArmArmedAway), Date: later}
+		}, func(d OverviewDiff) bool {
+			return d.ArmStateChanged && d.ArmState.StatusType == string(ArmArmedAway) &&
+				len(d.DoorWindows)+len(d.SmartPlugs)+len(d.ClimateValues) == 0
+		}},
+		{"door", func(o *Overview) {
+			o.DoorWindow.DoorWindowDevice = []DoorWindowDevice{{DeviceLabel: "DW1", State: "OPEN"}}
+		}, func(d OverviewDiff) bool {
+			return !d.ArmStateChanged && len(d.DoorWindows) == 1 && d.DoorWindows[0].State == "OPEN"
+		}},
+		{"new door", func(o *Overview) {
+			o.DoorWindow.DoorWindowDevice = append(o.DoorWindow.DoorWindowDevice, DoorWindowDevice{DeviceLabel: "DW2", State: "CLOSE"})
+		}, func(d OverviewDiff) bool {
+			return len(d.DoorWindows) == 1 && d.DoorWindows[0].DeviceLabel == "DW2"
+		}},
+		{"plug", func(o *Overview) {
+			o.SmartPlugs = []SmartPlug{{DeviceLabel: "SP1", CurrentState: "ON"}}
+		}, func(d OverviewDiff) bool {
+			return len(d.SmartPlugs) == 1 && d.SmartPlugs[0].CurrentState == "ON" && len(d.DoorWindows) == 0
+		}},
+		{"climate", func(o *Overview) {
+			o.ClimateValues = []ClimateValue{{DeviceLabel: "CL1", Temperature: 21, Time: later}}
+		}, func(d OverviewDiff) bool {
+			return len(d.ClimateValues) == 1 && d.ClimateValues[0].Temperature == 21 && len(d.SmartPlugs) == 0
+		}},
+		{"same climate reading", func(o *Overview) {
+			o.ClimateValues = []ClimateValue{{DeviceLabel: "CL1", Temperature: 20, Time: o.ClimateValues[0].Time}}
+		}, OverviewDiff.Empty},
+	} {
+		o := diffBase()
+		tc.change(&o)
+		if d := o.Diff(diffBase()); !tc.check(d) {
+			t.Errorf("%s: %+v", tc.name, d)
+		}
+	}
+}
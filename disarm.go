@@ -0,0 +1,45 @@
+package verisure
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// disarmRetry is the first delay between DisarmWithDeadline attempts
+var disarmRetry = 250 * time.Millisecond
+
+// DisarmWithDeadline disarms the alarm, retrying network failures and
+// server errors until it succeeds or deadline passes. A wrong or malformed
+// code, missing permission and other rejections fail at once.
+func (v *Verisure) DisarmWithDeadline(ctx context.Context, code string, deadline time.Time) error {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		err := v.SetArmState(ctx, code, ArmDisarmed)
+		if err == nil || !isTransient(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(v.jitter(backoff(disarmRetry, attempt, pollInterval))):
+		}
+	}
+}
+
+// isTransient reports whether err is a network failure or server error
+// that may succeed if the request is sent again
+func isTransient(err error) bool {
+	switch e := err.(type) {
+	case *FailoverError:
+		return true
+	case *statusError:
+		return e.code >= 500
+	case net.Error:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// disarmAPI serves a compatible installation whose arm state command is
+// answered by put, counting the commands sent
+func disarmAPI(put http.HandlerFunc) (*int32, map[string]http.HandlerFunc) {
+	var puts int32
+	return &puts, map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(`{"armState":{"statusType":"ARMED_AWAY"},"armstateCompatible":true}`),
+		"PUT /installation/1/armstate/code": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&puts, 1)
+			put(w, r)
+		},
+		"GET /installation/1/code/result/tx": ok(`{"result":"OK"}`),
+	}
+}
+
+func TestDisarmWithDeadlineRetries(t *testing.T) {
+	defer func(d time.Duration) { disarmRetry = d }(disarmRetry)
+	disarmRetry = time.Millisecond
+
+	puts, routes := disarmAPI(sequence(
+		reply(http.StatusServiceUnavailable, `{}`),
+		reply(http.StatusBadGateway, `{}`),
+		ok(`{"armStateChangeTransactionId":"tx"}`)))
+	s := newAPI(routes)
+	defer s.Close()
+
+	v := login(t, s)
+	if err := v.DisarmWithDeadline(context.Background(), "1234", time.Now().Add(5*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(puts); n != 3 {
+		t.Errorf("%d commands, want 3", n)
+	}
+}
+
+func TestDisarmWithDeadlineWrongCode(t *testing.T) {
+	puts, routes := disarmAPI(reply(http.StatusBadRequest, `{"errorCode":"WRONG_CODE"}`))
+	s := newAPI(routes)
+	defer s.Close()
+
+	v := login(t, s)
+	if err := v.DisarmWithDeadline(context.Background(), "1234", time.Now().Add(5*time.Second)); err != ErrWrongCode {
+		t.Errorf("got %v, want ErrWrongCode", err)
+	}
+	if n := atomic.LoadInt32(puts); n != 1 {
+		t.Errorf("%d commands, want 1", n)
+	}
+}
+
+func TestDisarmWithDeadlineExpires(t *testing.T) {
+	defer func(d time.Duration) { disarmRetry = d }(disarmRetry)
+	disarmRetry = time.Millisecond
+
+	_, routes := disarmAPI(reply(http.StatusServiceUnavailable, `{}`))
+	s := newAPI(routes)
+	defer s.Close()
+
+	v := login(t, s)
+	err := v.DisarmWithDeadline(context.Background(), "1234", time.Now().Add(50*time.Millisecond))
+	if err == nil || !isTransient(err) && err != context.DeadlineExceeded {
+		t.Errorf("got %v, want the last transient error", err)
+	}
+}
@@ -0,0 +1,79 @@
+package verisure
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// OpenInterval is a span of time a door or window was open. Intervals cut
+// by the queried range start or end at its boundary, flagged by
+// OpenedBefore and StillOpen.
+type OpenInterval struct {
+	DeviceLabel  string
+	Opened       time.Time
+	Closed       time.Time
+	OpenedBefore bool
+	StillOpen    bool
+}
+
+// Duration the door or window was open
+func (i OpenInterval) Duration() time.Duration {
+	return i.Closed.Sub(i.Opened)
+}
+
+// DoorWindowHistory pairs the open and close events of a door or window
+// sensor between from and to into intervals, oldest first. Both bounds
+// are required. A close without an open is only taken to end an interval
+// opened before from when it is the first event; later ones are dropped.
+func (v *Verisure) DoorWindowHistory(ctx context.Context, deviceLabel string, from, to time.Time) ([]OpenInterval, error) {
+	if from.IsZero() || to.IsZero() {
+		return nil, errors.New("door window history: from and to are required")
+	}
+
+	es, err := v.allEvents(ctx, EventOptions{
+		Categories:   []string{EventDoorWindowOpened, EventDoorWindowClosed},
+		DeviceLabels: []string{deviceLabel},
+		From:         from,
+		To:           to})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(es, func(i, j int) bool { return es[i].EventTime.Before(es[j].EventTime) })
+
+	is := make([]OpenInterval, 0)
+	var open *OpenInterval
+	first := true
+	for _, e := range es {
+		if e.DeviceLabel != deviceLabel {
+			continue
+		}
+		wasFirst := first
+		first = false
+
+		switch {
+		case e.is(EventDoorWindowOpened):
+			if open == nil {
+				open = &OpenInterval{DeviceLabel: deviceLabel, Opened: e.EventTime}
+			}
+		case e.is(EventDoorWindowClosed):
+			if open == nil && !wasFirst {
+				continue
+			}
+			if open == nil {
+				open = &OpenInterval{DeviceLabel: deviceLabel, Opened: from, OpenedBefore: true}
+			}
+			open.Closed = e.EventTime
+			is = append(is, *open)
+			open = nil
+		}
+	}
+	if open != nil {
+		open.Closed = to
+		open.StillOpen = true
+		is = append(is, *open)
+	}
+
+	return is, nil
+}
@@ -0,0 +1,51 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDoorWindowHistory(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/eventlog": fixture(t, "doorwindow_events.json"),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	at := func(hour int) time.Time { return time.Date(2026, 1, 1, hour, 0, 0, 0, time.UTC) }
+	is, err := v.DoorWindowHistory(context.Background(), "DW1", at(0), at(23))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []OpenInterval{
+		{DeviceLabel: "DW1", Opened: at(0), Closed: at(8), OpenedBefore: true},
+		{DeviceLabel: "DW1", Opened: at(9), Closed: at(10)},
+		{DeviceLabel: "DW1", Opened: at(12), Closed: at(23), StillOpen: true},
+	}
+	if len(is) != len(want) {
+		t.Fatalf("intervals %+v", is)
+	}
+	for i := range want {
+		if !is[i].Opened.Equal(want[i].Opened) || !is[i].Closed.Equal(want[i].Closed) {
+			t.Errorf("interval %d: %+v, want %+v", i, is[i], want[i])
+		}
+		is[i].Opened, is[i].Closed = want[i].Opened, want[i].Closed
+	}
+	if !reflect.DeepEqual(is, want) {
+		t.Errorf("intervals %+v, want %+v", is, want)
+	}
+}
+
+func TestDoorWindowHistoryBounds(t *testing.T) {
+	v := New()
+	now := time.Now()
+	for _, r := range [][2]time.Time{{{}, now}, {now, {}}} {
+		if _, err := v.DoorWindowHistory(context.Background(), "DW1", r[0], r[1]); err == nil {
+			t.Errorf("from %v to %v: no error", r[0], r[1])
+		}
+	}
+}
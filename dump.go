@@ -0,0 +1,94 @@
+package verisure
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// redactedHeaders are replaced in dumps as they hold credentials
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// WithResponseDump writes every response, along with the request that
+// caused it, to a new file in dir for debugging. Credentials and cookies
+// are redacted, and bodies from the auth service, which hold tokens, are
+// left out. Other bodies are copied to the file as they are read, so the
+// dump holds what the client consumed.
+func WithResponseDump(dir string) Option {
+	return func(v *Verisure) {
+		v.middleware = append(v.middleware, func(rt http.RoundTripper) http.RoundTripper {
+			return &dumper{next: rt, dir: dir, auth: v.authURL + "/"}
+		})
+	}
+}
+
+type dumper struct {
+	next http.RoundTripper
+	dir  string
+	auth string
+	n    uint64
+}
+
+func (d *dumper) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := d.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	name := fmt.Sprintf("%s-%04d-%s.txt",
+		time.Now().UTC().Format("20060102T150405.000"),
+		atomic.AddUint64(&d.n, 1),
+		strings.Trim(strings.Replace(req.URL.Path, "/", "_", -1), "_"))
+	f, ferr := os.Create(filepath.Join(d.dir, name))
+	if ferr != nil {
+		return res, nil
+	}
+
+	fmt.Fprintf(f, "%s %s\n", req.Method, req.URL.Path)
+	writeRedacted(f, req.Header)
+	fmt.Fprintf(f, "\n%s\n", res.Status)
+	writeRedacted(f, res.Header)
+	fmt.Fprintln(f)
+
+	if strings.HasPrefix(req.URL.String(), d.auth) {
+		fmt.Fprintln(f, "(auth service body omitted)")
+		f.Close()
+		return res, nil
+	}
+	res.Body = &dumpBody{Reader: io.TeeReader(res.Body, f), body: res.Body, f: f}
+	return res, nil
+}
+
+func writeRedacted(w io.Writer, h http.Header) {
+	h = cloneHeader(h)
+	for _, k := range redactedHeaders {
+		if _, ok := h[k]; ok {
+			h.Set(k, "REDACTED")
+		}
+	}
+	h.Write(w)
+}
+
+func cloneHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, vs := range h {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
+}
+
+type dumpBody struct {
+	io.Reader
+	body io.ReadCloser
+	f    *os.File
+}
+
+func (b *dumpBody) Close() error {
+	b.f.Close()
+	return b.body.Close()
+}
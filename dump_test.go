@@ -0,0 +1,55 @@
+package verisure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResponseDump(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := tokenAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(`{"armState":{"statusType":"DISARMED"}}`),
+	})
+	defer s.Close()
+
+	v := login(t, s, WithAuthURL(s.URL+"/auth"), WithResponseDump(dir))
+	if _, err := v.Overview(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var all string
+	for _, f := range files {
+		bs, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		all += string(bs)
+	}
+
+	// cookie login, token login, installation search and overview
+	if len(files) != 4 {
+		t.Errorf("%d dumps, want 4", len(files))
+	}
+	for _, secret := range []string{`"access"`, `"refresh"`, "Bearer"} {
+		if strings.Contains(all, secret) {
+			t.Errorf("dump contains %s:\n%s", secret, all)
+		}
+	}
+	if !strings.Contains(all, `"statusType":"DISARMED"`) {
+		t.Errorf("overview body missing:\n%s", all)
+	}
+}
@@ -0,0 +1,118 @@
+package verisure
+
+import (
+	"errors"
+	"net"
+)
+
+var (
+	// ErrNoAttachment is returned when an event carries no attachment
+	ErrNoAttachment = errors.New("verisure: event has no attachment")
+
+	// ErrPermissionDenied is returned when the logged in user lacks the
+	// rights for a request, typically because it is owner-only
+	ErrPermissionDenied = errors.New("verisure: permission denied")
+
+	// ErrNoBaseURLs is returned by Login when no API hosts are configured
+	ErrNoBaseURLs = errors.New("verisure: no API base URLs configured")
+
+	// ErrFirmwareUpdateInProgress is returned when starting a firmware
+	// update while another one is running
+	ErrFirmwareUpdateInProgress = errors.New("verisure: firmware update already in progress")
+
+	// ErrNoFirmwareUpdate is returned when no newer firmware is available
+	ErrNoFirmwareUpdate = errors.New("verisure: no firmware update available")
+
+	// ErrStateNotConfirmed is returned when a device did not report the
+	// requested state in time
+	ErrStateNotConfirmed = errors.New("verisure: state change not confirmed")
+
+	// ErrNoInstallations is returned when no installations are known,
+	// usually because Login has not been called
+	ErrNoInstallations = errors.New("verisure: no installations")
+
+	// ErrNoInstallationSelected is returned when none of the known
+	// installations is selected
+	ErrNoInstallationSelected = errors.New("verisure: no installation selected")
+
+	// ErrNotSupported is returned when a device or installation lacks the
+	// capability a request needs
+	ErrNotSupported = errors.New("verisure: not supported")
+
+	// ErrResponseTooLarge is returned when a response body exceeds the
+	// limit set with WithMaxResponseBytes
+	ErrResponseTooLarge = errors.New("verisure: response body too large")
+
+	// ErrUnknownDevice is returned when no device has the given label
+	ErrUnknownDevice = errors.New("verisure: unknown device")
+
+	// ErrCodeRequired is returned when a command needs a PIN code and
+	// none was given
+	ErrCodeRequired = errors.New("verisure: code required")
+
+	// ErrInvalidCodeFormat is returned for PIN codes that are not numeric
+	// or do not have the length set with WithCodeLength
+	ErrInvalidCodeFormat = errors.New("verisure: invalid code format")
+
+	// ErrWrongCode is returned when the API rejects a PIN code
+	ErrWrongCode = errors.New("verisure: wrong code")
+
+	// ErrNoImages is returned when a camera has no stored images
+	ErrNoImages = errors.New("verisure: no images")
+
+	// ErrArmStateIncompatible is returned when arming an installation
+	// whose arm state is not compatible with the API
+	ErrArmStateIncompatible = errors.New("verisure: arm state not compatible")
+
+	// ErrInstallationGone is returned by LoadSession when the saved
+	// installation is no longer available; the first one is selected
+	ErrInstallationGone = errors.New("verisure: saved installation gone")
+
+	// ErrUnsupportedArmState is returned when arming to a state the
+	// installation does not support
+	ErrUnsupportedArmState = errors.New("verisure: unsupported arm state")
+
+	// ErrInstallationSearchTimeout is matched by the *SearchTimeoutError
+	// Login returns when the user was authenticated but looking up the
+	// installations timed out
+	ErrInstallationSearchTimeout = errors.New("verisure: installation search timed out")
+)
+
+// SearchTimeoutError is returned by Login when the installation search
+// times out, either because ctx expired or because of a client or network
+// timeout. It matches ErrInstallationSearchTimeout with errors.Is and holds
+// the underlying error.
+type SearchTimeoutError struct {
+	Err error
+}
+
+func (e *SearchTimeoutError) Error() string {
+	return ErrInstallationSearchTimeout.Error() + ": " + e.Err.Error()
+}
+
+// Is reports whether target is ErrInstallationSearchTimeout
+func (e *SearchTimeoutError) Is(target error) bool {
+	return target == ErrInstallationSearchTimeout
+}
+
+// Unwrap returns the underlying error
+func (e *SearchTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// isTimeout reports whether err is a network timeout, on every host it was
+// tried on
+func isTimeout(err error) bool {
+	switch e := err.(type) {
+	case *FailoverError:
+		for _, err := range e.Errors {
+			if !isTimeout(err) {
+				return false
+			}
+		}
+		return len(e.Errors) > 0
+	case net.Error:
+		return e.Timeout()
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package verisure
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestInstallationSearchTimeout(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search": func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		},
+	})
+	defer s.Close()
+
+	for _, tc := range []struct {
+		name    string
+		opts    []Option
+		timeout time.Duration
+	}{
+		{"context deadline", nil, 50 * time.Millisecond},
+		{"client timeout", []Option{WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond})}, time.Minute},
+	} {
+		v := New(append([]Option{WithBaseURLs(s.URL)}, tc.opts...)...)
+		ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+		err := v.Login(ctx, "user@example.com", "password")
+		cancel()
+
+		se, ok := err.(*SearchTimeoutError)
+		if !ok || !se.Is(ErrInstallationSearchTimeout) {
+			t.Errorf("%s: got %v, want *SearchTimeoutError", tc.name, err)
+			continue
+		}
+		if ne, ok := se.Unwrap().(net.Error); !ok || !ne.Timeout() {
+			t.Errorf("%s: underlying error %v is not a timeout", tc.name, se.Err)
+		}
+	}
+}
+
+func TestInstallationSearchError(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search": reply(http.StatusBadRequest, `{}`),
+	})
+	defer s.Close()
+
+	v := New(WithBaseURLs(s.URL))
+	err := v.Login(context.Background(), "user@example.com", "password")
+	if _, ok := err.(*SearchTimeoutError); ok || err == nil {
+		t.Errorf("got %v, want the status error", err)
+	}
+}
@@ -0,0 +1,280 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event from the installation event log
+type Event struct {
+	EventID       string    `json:"eventId"`
+	EventType     string    `json:"eventType"`
+	EventCategory string    `json:"eventCategory"`
+	DeviceLabel   string    `json:"deviceLabel"`
+	Area          string    `json:"area"`
+	UserName      string    `json:"userName"`
+	EventTime     time.Time `json:"eventTime"`
+	AttachmentURL string    `json:"attachmentUrl,omitempty"`
+	Method        string    `json:"method,omitempty"`
+}
+
+// Event categories
+const (
+	EventDoorWindowOpened = "DOORWINDOW_STATE_OPENED"
+	EventDoorWindowClosed = "DOORWINDOW_STATE_CLOSED"
+	EventDoorLockLocked   = "DOORLOCK_LOCKED"
+	EventDoorLockUnlocked = "DOORLOCK_UNLOCKED"
+)
+
+// is reports whether e is of the given category. Older event logs only
+// carry it as the event type, so both fields are matched.
+func (e Event) is(category string) bool {
+	return e.EventCategory == category || e.EventType == category
+}
+
+// EventOptions filter and page the event log. Zero values leave the
+// corresponding filter out. Limit caps the events an EventIterator yields.
+type EventOptions struct {
+	Offset       int
+	PageSize     int
+	Limit        int
+	Categories   []string
+	DeviceLabels []string
+	From         time.Time
+	To           time.Time
+}
+
+// NewEventOptions returns options for the last 24 hours of events in all
+// categories, 15 per page. Refine them with the With methods:
+//
+//	opts := NewEventOptions().WithCategories(EventDoorWindowOpened).WithDevice("ABCD EFGH")
+func NewEventOptions() EventOptions {
+	now := time.Now()
+	return EventOptions{
+		PageSize: 15,
+		From:     now.Add(-24 * time.Hour),
+		To:       now}
+}
+
+// WithCategories limits the events to the given categories
+func (o EventOptions) WithCategories(categories ...string) EventOptions {
+	o.Categories = append([]string(nil), categories...)
+	return o
+}
+
+// WithRange limits the events to those between from and to
+func (o EventOptions) WithRange(from, to time.Time) EventOptions {
+	o.From, o.To = from, to
+	return o
+}
+
+// WithDevice adds a device to limit the events to
+func (o EventOptions) WithDevice(deviceLabel string) EventOptions {
+	o.DeviceLabels = append(append([]string(nil), o.DeviceLabels...), deviceLabel)
+	return o
+}
+
+func (o EventOptions) query() url.Values {
+	q := url.Values{}
+	q.Set("offset", strconv.Itoa(o.Offset))
+	if o.PageSize > 0 {
+		q.Set("pagesize", strconv.Itoa(o.PageSize))
+	}
+	for _, c := range o.Categories {
+		q.Add("notificationCategories", c)
+	}
+	for _, d := range o.DeviceLabels {
+		q.Add("eventDeviceLabels", d)
+	}
+	if !o.From.IsZero() {
+		q.Set("fromDate", o.From.UTC().Format(time.RFC3339))
+	}
+	if !o.To.IsZero() {
+		q.Set("toDate", o.To.UTC().Format(time.RFC3339))
+	}
+	return q
+}
+
+// defaultEventPageSize used when paging through the whole event log
+const defaultEventPageSize = 50
+
+type eventLog struct {
+	EventLogItems []Event `json:"eventLogItems"`
+}
+
+// Events ...
+func (v *Verisure) Events(ctx context.Context, opts EventOptions) ([]Event, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var l eventLog
+	path := fmt.Sprintf("/installation/%s/eventlog?%s", giid, opts.query().Encode())
+	if err := v.call(ctx, "events", http.MethodGet, path, nil, &l); err != nil {
+		return nil, err
+	}
+
+	return l.EventLogItems, nil
+}
+
+// allEvents pages through every event matching opts
+func (v *Verisure) allEvents(ctx context.Context, opts EventOptions) ([]Event, error) {
+	var all []Event
+	it := v.EventsIterator(ctx, opts)
+	for it.Next() {
+		all = append(all, it.Event())
+	}
+
+	return all, it.Err()
+}
+
+// EventIterator walks the event log, fetching pages as needed. Call Next
+// until it returns false, then check Err.
+type EventIterator struct {
+	v     *Verisure
+	ctx   context.Context
+	opts  EventOptions
+	page  []Event
+	event Event
+	seen  int
+	done  bool
+	err   error
+}
+
+// EventsIterator returns an iterator over the events matching opts,
+// starting at opts.Offset and stopping after opts.Limit events if set
+func (v *Verisure) EventsIterator(ctx context.Context, opts EventOptions) *EventIterator {
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultEventPageSize
+	}
+
+	return &EventIterator{v: v, ctx: ctx, opts: opts}
+}
+
+// Next advances to the next event, reporting false when there are no more
+// events or an error occurred
+func (it *EventIterator) Next() bool {
+	if it.err != nil || (it.opts.Limit > 0 && it.seen >= it.opts.Limit) {
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		es, err := it.v.Events(it.ctx, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = es
+		it.opts.Offset += len(es)
+		it.done = len(es) < it.opts.PageSize
+		if len(it.page) == 0 {
+			return false
+		}
+	}
+
+	it.event, it.page = it.page[0], it.page[1:]
+	it.seen++
+
+	return true
+}
+
+// Event the iterator is positioned at
+func (it *EventIterator) Event() Event {
+	return it.event
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *EventIterator) Err() error {
+	return it.err
+}
+
+// ClearEventBadge marks every event as seen, resetting the unread count
+// shown in the Verisure app. Unlike acknowledging a single notification it
+// does not change any event, only the user's read marker. It succeeds when
+// there is nothing unread.
+func (v *Verisure) ClearEventBadge(ctx context.Context) error {
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/eventlog/seen", giid)
+	return v.call(ctx, "clear event badge", http.MethodPut, path, nil, nil)
+}
+
+// EventAttachment streams the image attached to e. The caller must close
+// the returned reader. ErrNoAttachment is returned for events without one.
+func (v *Verisure) EventAttachment(ctx context.Context, e Event) (io.ReadCloser, error) {
+	if e.AttachmentURL == "" {
+		return nil, ErrNoAttachment
+	}
+
+	u, err := url.Parse(e.AttachmentURL)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsAbs() {
+		base, err := url.Parse(v.host() + "/")
+		if err != nil {
+			return nil, err
+		}
+		// Paths are relative to the API base, prefix included, as for
+		// every other request
+		if u.Host == "" {
+			u.Path = base.Path + strings.TrimPrefix(u.Path, "/")
+			u.RawPath = ""
+		}
+		u = base.ResolveReference(u)
+	}
+
+	return v.stream(ctx, "attachment", u.String())
+}
+
+type eventConfig struct {
+	Categories []string `json:"categories"`
+}
+
+// EventCategories returns the categories the selected installation's
+// devices can produce, for use in EventOptions.Categories. The result is
+// cached per installation until the next Login.
+func (v *Verisure) EventCategories(ctx context.Context) ([]string, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+	v.shared().mu.Lock()
+	cs, ok := v.eventCategories[giid]
+	v.shared().mu.Unlock()
+	if ok {
+		return append([]string(nil), cs...), nil
+	}
+
+	var c eventConfig
+	path := fmt.Sprintf("/installation/%s/eventconfig", giid)
+	if err := v.call(ctx, "event categories", http.MethodGet, path, nil, &c); err != nil {
+		return nil, err
+	}
+	v.shared().mu.Lock()
+	if v.eventCategories == nil {
+		v.eventCategories = make(map[string][]string)
+	}
+	v.eventCategories[giid] = c.Categories
+	v.shared().mu.Unlock()
+
+	return append([]string(nil), c.Categories...), nil
+}
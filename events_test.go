@@ -0,0 +1,104 @@
+package verisure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEventOptionsQuery(t *testing.T) {
+	var got url.Values
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/eventlog": func(w http.ResponseWriter, r *http.Request) {
+			got = r.URL.Query()
+			w.Write([]byte(`{"eventLogItems":[]}`))
+		},
+	})
+	defer s.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+	to := from.Add(24 * time.Hour)
+	opts := NewEventOptions().
+		WithCategories(EventDoorWindowOpened, EventDoorWindowClosed).
+		WithRange(from, to).
+		WithDevice("ABCD EFGH").
+		WithDevice("IJKL MNOP")
+
+	v := login(t, s)
+	if _, err := v.Events(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{
+		"offset":                 {"0"},
+		"pagesize":               {"15"},
+		"notificationCategories": {EventDoorWindowOpened, EventDoorWindowClosed},
+		"eventDeviceLabels":      {"ABCD EFGH", "IJKL MNOP"},
+		"fromDate":               {"2025-12-31T23:00:00Z"},
+		"toDate":                 {"2026-01-01T23:00:00Z"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("query %v, want %v", got, want)
+	}
+}
+
+func TestNewEventOptions(t *testing.T) {
+	o := NewEventOptions()
+	if o.PageSize != 15 || len(o.Categories) != 0 || o.To.Sub(o.From) != 24*time.Hour {
+		t.Errorf("defaults %+v", o)
+	}
+
+	// Setters return copies and leave the receiver alone
+	a := o.WithDevice("A")
+	b := a.WithDevice("B")
+	if len(o.DeviceLabels) != 0 || len(a.DeviceLabels) != 1 || len(b.DeviceLabels) != 2 {
+		t.Errorf("devices %v, %v, %v", o.DeviceLabels, a.DeviceLabels, b.DeviceLabels)
+	}
+}
+
+func TestEventAttachmentRelative(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"POST /xbn/2/cookie":             defaultRoutes["POST /cookie"],
+		"GET /xbn/2/installation/search": ok(`[{"giid":"1"}]`),
+		"GET /xbn/2/attachment/x":        ok("image"),
+	})
+	defer s.Close()
+
+	v := New(WithBaseURLs(s.URL + "/xbn/2"))
+	ctx := context.Background()
+	if err := v.Login(ctx, "user@example.com", "password"); err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{"/attachment/x", "attachment/x"} {
+		rc, err := v.EventAttachment(ctx, Event{AttachmentURL: ref})
+		if err != nil {
+			t.Errorf("%s: %v", ref, err)
+			continue
+		}
+		bs, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil || string(bs) != "image" {
+			t.Errorf("%s: got %q, %v", ref, bs, err)
+		}
+	}
+}
+
+func TestEventIs(t *testing.T) {
+	for _, tc := range []struct {
+		e    Event
+		want bool
+	}{
+		{Event{EventCategory: EventDoorLockLocked}, true},
+		{Event{EventType: EventDoorLockLocked}, true},
+		{Event{EventCategory: EventDoorLockUnlocked, EventType: EventDoorLockUnlocked}, false},
+		{Event{}, false},
+	} {
+		if got := tc.e.is(EventDoorLockLocked); got != tc.want {
+			t.Errorf("%+v: is = %v", tc.e, got)
+		}
+	}
+}
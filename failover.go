@@ -0,0 +1,80 @@
+package verisure
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// WithMaxFailover limits how many other hosts a request tries after the
+// current one fails, bounding latency when every host is down. 0 disables
+// failover. By default every configured host is tried once.
+func WithMaxFailover(n int) Option {
+	return func(v *Verisure) {
+		if n < 0 {
+			n = 0
+		}
+		v.maxFailover = n
+	}
+}
+
+// failoverHosts limits hosts to the current one plus the allowed failovers
+func (v *Verisure) failoverHosts(hosts []string) []string {
+	if v.maxFailover >= 0 && len(hosts) > v.maxFailover+1 {
+		return hosts[:v.maxFailover+1]
+	}
+	return hosts
+}
+
+// FailoverError is returned when a request failed on every host it tried
+type FailoverError struct {
+	Name   string
+	Hosts  []string
+	Errors []error
+}
+
+func (e *FailoverError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s: %d hosts failed", e.Name, len(e.Hosts))
+	for i, h := range e.Hosts {
+		fmt.Fprintf(&b, "; %s: %v", h, e.Errors[i])
+	}
+	return b.String()
+}
+
+// failed returns err directly if only one host was tried, otherwise all
+// collected failures
+func (e *FailoverError) failed() error {
+	if len(e.Errors) == 1 {
+		return e.Errors[0]
+	}
+	return e
+}
+
+// idempotent reports whether a request with method may be sent again
+// without repeating its effect
+func idempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// notSent reports whether err happened before the request was written,
+// while connecting or during the TLS handshake
+func notSent(err error) bool {
+	ue, ok := err.(*url.Error)
+	if !ok {
+		return false
+	}
+
+	switch e := ue.Err.(type) {
+	case *net.OpError:
+		return e.Op == "dial"
+	case *net.DNSError, tls.RecordHeaderError, x509.UnknownAuthorityError,
+		x509.HostnameError, x509.CertificateInvalidError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// dropping starts a host that reads each request and then drops the
+// connection without answering, with a func returning the number of
+// requests it got
+func dropping(t *testing.T) (*httptest.Server, func() int) {
+	var mu sync.Mutex
+	n := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		n++
+		mu.Unlock()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conn.Close()
+	}))
+	return s, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return n
+	}
+}
+
+func TestFailoverLogsEachHost(t *testing.T) {
+	bad := reply(http.StatusInternalServerError, "")
+	a := newAPI(map[string]http.HandlerFunc{"GET /installation/1/overview": bad})
+	defer a.Close()
+	b := newAPI(map[string]http.HandlerFunc{"GET /installation/1/overview": bad})
+	defer b.Close()
+	c := newAPI(map[string]http.HandlerFunc{"GET /installation/1/overview": ok(`{"totalSmsCount":3}`)})
+	defer c.Close()
+
+	var events []LogEvent
+	v := login(t, a, WithBaseURLs(a.URL, b.URL, c.URL), WithLogger(func(e LogEvent) { events = append(events, e) }))
+	o, err := v.Overview(context.Background())
+	if err != nil || o.TotalSmsCount != 3 {
+		t.Fatal(o, err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+	want := []LogEvent{
+		{Type: LogFailover, Host: a.URL, NextHost: b.URL, ErrorClass: "status 500 Internal Server Error"},
+		{Type: LogFailover, Host: b.URL, NextHost: c.URL, ErrorClass: "status 500 Internal Server Error"},
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, events[i], want[i])
+		}
+	}
+	if v.host() != c.URL {
+		t.Errorf("current host %s, want %s", v.host(), c.URL)
+	}
+}
+
+func TestFailoverSkipsCommands(t *testing.T) {
+	a, na := dropping(t)
+	defer a.Close()
+	b, nb := dropping(t)
+	defer b.Close()
+	s := newAPI(nil)
+	defer s.Close()
+
+	v := login(t, s)
+	v.baseURLs = []string{a.URL, b.URL}
+	v.setHost(a.URL)
+	if err := v.TriggerPanic(context.Background(), PanicFire); err == nil {
+		t.Fatal("no error")
+	}
+	if n := na() + nb(); n != 1 {
+		t.Errorf("panic sent %d times, want once", n)
+	}
+}
+
+func TestFailoverUnreachableCommand(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	s := newAPI(map[string]http.HandlerFunc{"POST /installation/1/panic": ok("")})
+	defer s.Close()
+
+	v := login(t, s)
+	v.baseURLs = []string{down.URL, s.URL}
+	v.setHost(down.URL)
+	if err := v.TriggerPanic(context.Background(), PanicFire); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSingleHost(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": reply(http.StatusInternalServerError, ""),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	_, err := v.Overview(context.Background())
+	se, ok := err.(*statusError)
+	if !ok || se.code != http.StatusInternalServerError {
+		t.Errorf("got %#v, want the host's own error", err)
+	}
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	w := New(WithBaseURLs(closed.URL))
+	if err := w.Login(context.Background(), "user@example.com", "password"); err == nil {
+		t.Error("login to a closed host succeeded")
+	} else if _, ok := err.(*FailoverError); ok {
+		t.Errorf("single host login error wrapped: %v", err)
+	}
+}
+
+func TestNoHosts(t *testing.T) {
+	v := New(WithBaseURLs())
+	if err := v.Login(context.Background(), "user@example.com", "password"); err != ErrNoBaseURLs {
+		t.Errorf("got %v, want ErrNoBaseURLs", err)
+	}
+}
+
+func TestMaxFailover(t *testing.T) {
+	var servers []*httptest.Server
+	var urls []string
+	hits := make([]int32, 3)
+	for i := range hits {
+		i := i
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits[i], 1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+		})
+		defer s.Close()
+		servers = append(servers, s)
+		urls = append(urls, s.URL)
+	}
+
+	v := login(t, servers[0], WithBaseURLs(urls...), WithMaxFailover(1))
+	_, err := v.Overview(context.Background())
+	fe, ok := err.(*FailoverError)
+	if !ok {
+		t.Fatalf("got %v, want *FailoverError", err)
+	}
+	if len(fe.Hosts) != 2 || fe.Hosts[0] != urls[0] || fe.Hosts[1] != urls[1] || len(fe.Errors) != 2 {
+		t.Errorf("failures %+v", fe)
+	}
+	for _, e := range fe.Errors {
+		if se, ok := e.(*statusError); !ok || se.code != http.StatusServiceUnavailable {
+			t.Errorf("per host error %v", e)
+		}
+	}
+	got := []int32{atomic.LoadInt32(&hits[0]), atomic.LoadInt32(&hits[1]), atomic.LoadInt32(&hits[2])}
+	if got[0] != 1 || got[1] != 1 || got[2] != 0 {
+		t.Errorf("hits per host %v", got)
+	}
+}
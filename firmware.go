@@ -0,0 +1,98 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// FirmwareStatus of the panel
+type FirmwareStatus struct {
+	CurrentVersion   int  `json:"currentVersion"`
+	AvailableVersion int  `json:"availableVersion"`
+	UpdateAvailable  bool `json:"updateAvailable"`
+	UpdateInProgress bool `json:"updateInProgress"`
+}
+
+// FirmwareStatus ...
+func (v *Verisure) FirmwareStatus(ctx context.Context) (FirmwareStatus, error) {
+	var s FirmwareStatus
+	inst, err := v.activeInstallation()
+	if err != nil {
+		return s, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/firmware/status", inst.GIID)
+	if err := v.call(ctx, "firmware status", http.MethodGet, path, nil, &s); err != nil {
+		return s, err
+	}
+	if s.CurrentVersion == 0 {
+		s.CurrentVersion = inst.FirmwareVersion
+	}
+
+	return s, nil
+}
+
+// StartFirmwareUpdate starts installing the available panel firmware. Only
+// the installation owner may start it, and it fails with
+// ErrFirmwareUpdateInProgress if an update is already running.
+func (v *Verisure) StartFirmwareUpdate(ctx context.Context) error {
+	if err := v.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	s, err := v.FirmwareStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if s.UpdateInProgress {
+		return ErrFirmwareUpdateInProgress
+	}
+	if !s.UpdateAvailable {
+		return ErrNoFirmwareUpdate
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/firmware/update", giid)
+	return v.call(ctx, "firmware update", http.MethodPost, path, nil, nil)
+}
+
+// FirmwareNA is the DeviceFirmware version of devices without updatable
+// firmware
+const FirmwareNA = "N/A"
+
+// DeviceFirmware of a single device
+type DeviceFirmware struct {
+	DeviceLabel     string
+	DeviceType      string
+	Version         string
+	UpdateAvailable bool
+}
+
+// DeviceFirmware lists the firmware version of every device, see
+// FirmwareStatus for the panel
+func (v *Verisure) DeviceFirmware(ctx context.Context) ([]DeviceFirmware, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := make([]DeviceFirmware, 0, len(ds))
+	for _, d := range ds {
+		f := DeviceFirmware{
+			DeviceLabel:     d.DeviceLabel,
+			DeviceType:      d.DeviceType,
+			Version:         d.FirmwareVersion,
+			UpdateAvailable: d.FirmwareUpdate}
+		if f.Version == "" {
+			f.Version = FirmwareNA
+		}
+		fs = append(fs, f)
+	}
+
+	return fs, nil
+}
@@ -0,0 +1,38 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStartFirmwareUpdateOwnerOnly(t *testing.T) {
+	for _, tc := range []struct {
+		detail string
+		err    error
+		posts  int32
+	}{
+		{"installation_member.json", ErrPermissionDenied, 0},
+		{"installation_owner.json", nil, 1},
+	} {
+		var posts int32
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/permissions":     ok(`{"permissions":["ARM","ADMIN"]}`),
+			"GET /installation/1/":                fixture(t, tc.detail),
+			"GET /installation/1/firmware/status": ok(`{"currentVersion":1,"availableVersion":2,"updateAvailable":true}`),
+			"POST /installation/1/firmware/update": func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&posts, 1)
+			},
+		})
+
+		v := login(t, s)
+		if err := v.StartFirmwareUpdate(context.Background()); err != tc.err {
+			t.Errorf("%s: got %v, want %v", tc.detail, err, tc.err)
+		}
+		if n := atomic.LoadInt32(&posts); n != tc.posts {
+			t.Errorf("%s: %d updates started, want %d", tc.detail, n, tc.posts)
+		}
+		s.Close()
+	}
+}
@@ -0,0 +1,135 @@
+package verisure
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	floatType = reflect.TypeOf(float64(0))
+	intType   = reflect.TypeOf(int(0))
+)
+
+// flexFloat decodes from both JSON numbers and numeric strings, as some
+// firmware versions quote numbers
+type flexFloat float64
+
+func (f *flexFloat) UnmarshalJSON(data []byte) error {
+	s, ok := flexString(data)
+	if !ok {
+		*f = 0
+		return nil
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: "string " + s, Type: floatType}
+	}
+	*f = flexFloat(n)
+
+	return nil
+}
+
+// flexInt is the integer counterpart of flexFloat
+type flexInt int
+
+func (i *flexInt) UnmarshalJSON(data []byte) error {
+	s, ok := flexString(data)
+	if !ok {
+		*i = 0
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: "string " + s, Type: intType}
+	}
+	*i = flexInt(n)
+
+	return nil
+}
+
+// flexTime decodes "" and null to the zero time, as devices that never
+// reported send empty timestamps
+type flexTime time.Time
+
+func (t *flexTime) UnmarshalJSON(data []byte) error {
+	if _, ok := flexString(data); !ok {
+		*t = flexTime{}
+		return nil
+	}
+
+	var tt time.Time
+	if err := json.Unmarshal(data, &tt); err != nil {
+		return err
+	}
+	*t = flexTime(tt)
+
+	return nil
+}
+
+// flexString unquotes a JSON number or string, reporting false for null
+// and empty values
+func flexString(data []byte) (string, bool) {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		return "", false
+	}
+	return string(data), true
+}
+
+// UnmarshalJSON accepts temperature and humidity as numbers or strings and
+// an empty time
+func (c *ClimateValue) UnmarshalJSON(data []byte) error {
+	type climateValue ClimateValue
+	aux := struct {
+		*climateValue
+		Temperature flexFloat `json:"temperature"`
+		Humidity    flexFloat `json:"humidity"`
+		Time        flexTime  `json:"time"`
+	}{climateValue: (*climateValue)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	c.Temperature = float64(aux.Temperature)
+	c.Humidity = float64(aux.Humidity)
+	c.Time = time.Time(aux.Time)
+
+	return nil
+}
+
+// UnmarshalJSON accepts an empty report time
+func (d *DoorWindowDevice) UnmarshalJSON(data []byte) error {
+	type doorWindowDevice DoorWindowDevice
+	aux := struct {
+		*doorWindowDevice
+		ReportTime flexTime `json:"reportTime"`
+	}{doorWindowDevice: (*doorWindowDevice)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d.ReportTime = time.Time(aux.ReportTime)
+
+	return nil
+}
+
+// UnmarshalJSON accepts an empty test date
+func (s *LatestEthernetStatus) UnmarshalJSON(data []byte) error {
+	type latestEthernetStatus LatestEthernetStatus
+	aux := struct {
+		*latestEthernetStatus
+		TestDate flexTime `json:"testDate"`
+	}{latestEthernetStatus: (*latestEthernetStatus)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	s.TestDate = time.Time(aux.TestDate)
+
+	return nil
+}
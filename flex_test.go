@@ -0,0 +1,75 @@
+package verisure
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestNumericStrings(t *testing.T) {
+	for _, name := range []string{"overview_numbers.json", "overview_strings.json"} {
+		bs, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var o Overview
+		if err := json.Unmarshal(bs, &o); err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if o.TotalSmsCount != 7 {
+			t.Errorf("%s: totalSmsCount %d", name, o.TotalSmsCount)
+		}
+		if len(o.ClimateValues) != 1 || o.ClimateValues[0].Temperature != 21.3 || o.ClimateValues[0].Humidity != 45 {
+			t.Errorf("%s: climate %+v", name, o.ClimateValues)
+		}
+	}
+}
+
+func TestNumericStringErrors(t *testing.T) {
+	for _, doc := range []string{
+		`{"totalSmsCount":"seven"}`,
+		`{"climateValues":[{"temperature":"warm"}]}`,
+	} {
+		var o Overview
+		if err := json.Unmarshal([]byte(doc), &o); err == nil {
+			t.Errorf("%s: no error", doc)
+		}
+	}
+
+	var c ClimateValue
+	if err := json.Unmarshal([]byte(`{"temperature":null,"humidity":""}`), &c); err != nil || c.Temperature != 0 || c.Humidity != 0 {
+		t.Errorf("null and empty: %+v, %v", c, err)
+	}
+}
+
+func TestEmptyTimestamps(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("testdata", "overview_empty_times.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o Overview
+	if err := json.Unmarshal(bs, &o); err != nil {
+		t.Fatal(err)
+	}
+
+	dws := o.DoorWindow.DoorWindowDevice
+	if len(dws) != 3 || !dws[0].ReportTime.IsZero() || !dws[1].ReportTime.IsZero() || dws[2].ReportTime.IsZero() {
+		t.Errorf("door windows %+v", dws)
+	}
+	if dws[0].State != "CLOSE" || dws[2].ReportTime.Hour() != 8 {
+		t.Errorf("other fields lost: %+v", dws)
+	}
+	if !o.LatestEthernetStatus.TestDate.IsZero() || o.LatestEthernetStatus.DeviceLabel != "ETH1" {
+		t.Errorf("ethernet status %+v", o.LatestEthernetStatus)
+	}
+	if len(o.ClimateValues) != 1 || !o.ClimateValues[0].Time.IsZero() || o.ClimateValues[0].Temperature != 20.5 {
+		t.Errorf("climate %+v", o.ClimateValues)
+	}
+
+	var dw DoorWindowDevice
+	if err := json.Unmarshal([]byte(`{"reportTime":"yesterday"}`), &dw); err == nil {
+		t.Error("malformed timestamp accepted")
+	}
+}
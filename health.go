@@ -0,0 +1,132 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// BatteryLow is the battery state of a device that needs a new battery
+const BatteryLow = "LOW"
+
+// Severity of a health issue, higher is worse
+type Severity int
+
+// Severity levels
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityCritical:
+		return "critical"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// HealthIssue found by Health. DeviceLabel is empty for installation wide
+// issues.
+type HealthIssue struct {
+	Severity    Severity
+	Kind        string
+	DeviceLabel string
+	Message     string
+}
+
+// Health issue kinds
+const (
+	HealthEthernet     = "ETHERNET"
+	HealthInstallation = "INSTALLATION"
+	HealthBattery      = "BATTERY"
+	HealthOffline      = "OFFLINE"
+	HealthTamper       = "TAMPER"
+)
+
+// HealthReport lists issues worst first
+type HealthReport struct {
+	Issues []HealthIssue
+}
+
+// OK reports whether no issues were found
+func (r HealthReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Worst severity in the report, SeverityInfo if there are no issues
+func (r HealthReport) Worst() Severity {
+	if len(r.Issues) == 0 {
+		return SeverityInfo
+	}
+	return r.Issues[0].Severity
+}
+
+// Health collects ethernet status, installation errors, low batteries,
+// offline devices and tamper into a single report. The overview and the
+// device list are fetched concurrently.
+func (v *Verisure) Health(ctx context.Context) (HealthReport, error) {
+	var (
+		wg          sync.WaitGroup
+		o           Overview
+		ds          []Device
+		oErr, dsErr error
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		o, oErr = v.Overview(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		ds, dsErr = v.Devices(ctx)
+	}()
+	wg.Wait()
+
+	if oErr != nil {
+		return HealthReport{}, oErr
+	}
+	if dsErr != nil {
+		return HealthReport{}, dsErr
+	}
+
+	var r HealthReport
+	add := func(s Severity, kind, label, msg string) {
+		r.Issues = append(r.Issues, HealthIssue{s, kind, label, msg})
+	}
+
+	if o.EthernetModeActive && !o.EthernetConnectedNow {
+		add(SeverityCritical, HealthEthernet, "", "ethernet disconnected")
+	} else if !o.LatestEthernetStatus.TestDate.IsZero() && !o.LatestEthernetStatus.LatestEthernetTestResult {
+		add(SeverityWarning, HealthEthernet, o.LatestEthernetStatus.DeviceLabel, "latest ethernet test failed")
+	}
+
+	for _, e := range o.InstallationErrorList {
+		add(SeverityCritical, HealthInstallation, e.DeviceLabel, e.String())
+	}
+
+	for _, d := range ds {
+		if d.Tamper {
+			add(SeverityCritical, HealthTamper, d.DeviceLabel, "tamper")
+		}
+		if !d.Online {
+			add(SeverityWarning, HealthOffline, d.DeviceLabel, "offline")
+		}
+		if d.Battery == BatteryLow {
+			add(SeverityWarning, HealthBattery, d.DeviceLabel, "low battery")
+		}
+	}
+
+	sort.SliceStable(r.Issues, func(i, j int) bool {
+		return r.Issues[i].Severity > r.Issues[j].Severity
+	})
+
+	return r, nil
+}
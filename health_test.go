@@ -0,0 +1,37 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestHealth(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": fixture(t, "health_overview.json"),
+		"GET /installation/1/device":   fixture(t, "health_devices.json"),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	r, err := v.Health(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []HealthIssue{
+		{SeverityCritical, HealthEthernet, "", "ethernet disconnected"},
+		{SeverityCritical, HealthInstallation, "SIR1", "Siren not responding"},
+		{SeverityCritical, HealthInstallation, "", "Panel needs service"},
+		{SeverityCritical, HealthTamper, "PIR1", "tamper"},
+		{SeverityWarning, HealthOffline, "DW2", "offline"},
+		{SeverityWarning, HealthBattery, "DW2", "low battery"},
+	}
+	if !reflect.DeepEqual(r.Issues, want) {
+		t.Errorf("issues\n%+v\nwant\n%+v", r.Issues, want)
+	}
+	if r.OK() || r.Worst() != SeverityCritical {
+		t.Errorf("OK %v, worst %v", r.OK(), r.Worst())
+	}
+}
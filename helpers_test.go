@@ -0,0 +1,89 @@
+package verisure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+// reply answers with status and a JSON body
+func reply(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", mediaType)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+// ok answers 200 with a JSON body
+func ok(body string) http.HandlerFunc {
+	return reply(http.StatusOK, body)
+}
+
+// fixture answers 200 with the contents of a file in testdata
+func fixture(t *testing.T, name string) http.HandlerFunc {
+	t.Helper()
+	bs, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ok(string(bs))
+}
+
+// sequence answers the nth request with the nth handler, repeating the
+// last one
+func sequence(hs ...http.HandlerFunc) http.HandlerFunc {
+	var n int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&n, 1)) - 1
+		if i >= len(hs) {
+			i = len(hs) - 1
+		}
+		hs[i](w, r)
+	}
+}
+
+// defaultRoutes let a client log in to installation "1" and out again
+var defaultRoutes = map[string]http.HandlerFunc{
+	"POST /cookie": func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: "session", Path: "/"})
+	},
+	"DELETE /cookie":           ok(""),
+	"GET /installation/search": ok(`[{"giid":"1"}]`),
+}
+
+// newAPI starts a fake API. Routes are keyed by "METHOD /path", falling
+// back to defaultRoutes; anything else is a 404.
+func newAPI(routes map[string]http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(api(routes))
+}
+
+// api routes requests like newAPI
+func api(routes map[string]http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		if h, ok := routes[key]; ok {
+			h(w, r)
+			return
+		}
+		if h, ok := defaultRoutes[key]; ok {
+			h(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	}
+}
+
+// login returns a client logged in to s
+func login(t *testing.T, s *httptest.Server, opts ...Option) *Verisure {
+	t.Helper()
+	v := New(append([]Option{WithBaseURLs(s.URL)}, opts...)...)
+	if err := v.Login(context.Background(), "user@example.com", "password"); err != nil {
+		t.Fatal(err)
+	}
+	return &v
+}
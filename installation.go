@@ -0,0 +1,83 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Installations returns the installations found at Login
+func (v *Verisure) Installations() []Installation {
+	return append([]Installation(nil), v.installations...)
+}
+
+// UnmarshalJSON accepts the numeric fields as numbers or strings, as some
+// locales send them quoted
+func (i *Installation) UnmarshalJSON(data []byte) error {
+	type installation Installation
+	aux := struct {
+		*installation
+		FirmwareVersion flexInt `json:"firmwareVersion"`
+		Shard           flexInt `json:"shard"`
+		SignalFilterID  flexInt `json:"signalFilterId"`
+	}{installation: (*installation)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	i.FirmwareVersion = int(aux.FirmwareVersion)
+	i.Shard = int(aux.Shard)
+	i.SignalFilterID = int(aux.SignalFilterID)
+
+	return nil
+}
+
+// Address formats the installation's street address on one line, such as
+// "Storgatan 12, lgh 1102". Missing parts are left out.
+func (i Installation) Address() string {
+	street := strings.TrimSpace(strings.Join([]string{
+		strings.TrimSpace(i.Street),
+		strings.TrimSpace(i.StreetNo1)}, " "))
+	no2 := strings.TrimSpace(i.StreetNo2)
+
+	switch {
+	case street == "":
+		return no2
+	case no2 == "":
+		return street
+	}
+	return street + ", " + no2
+}
+
+// InstallationDetail of the selected installation. Users who are not the
+// owner only see the owner's name, OwnerEmail and Created are then empty.
+type InstallationDetail struct {
+	GIID       string    `json:"giid"`
+	Created    time.Time `json:"createdDate"`
+	OwnerName  string    `json:"ownerName"`
+	OwnerEmail string    `json:"ownerEmail"`
+	IsOwner    bool      `json:"isOwner"`
+}
+
+// InstallationDetail returns when the selected installation was created and
+// who owns it
+func (v *Verisure) InstallationDetail(ctx context.Context) (InstallationDetail, error) {
+	var d InstallationDetail
+	giid, err := v.giid()
+	if err != nil {
+		return d, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/", giid)
+	if err := v.call(ctx, "installation detail", http.MethodGet, path, nil, &d); err != nil {
+		return d, err
+	}
+	if d.GIID == "" {
+		d.GIID = giid
+	}
+
+	return d, nil
+}
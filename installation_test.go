@@ -0,0 +1,79 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAddress(t *testing.T) {
+	for _, tc := range []struct {
+		inst Installation
+		want string
+	}{
+		{Installation{Street: "Storgatan", StreetNo1: "12", StreetNo2: "lgh 1101"}, "Storgatan 12, lgh 1101"},
+		{Installation{Street: " Storgatan ", StreetNo1: " "}, "Storgatan"},
+		{Installation{Street: "Storgatan", StreetNo2: "c/o Svensson"}, "Storgatan, c/o Svensson"},
+		{Installation{StreetNo2: "Box 42"}, "Box 42"},
+		{Installation{}, ""},
+	} {
+		if got := tc.inst.Address(); got != tc.want {
+			t.Errorf("%+v: %q, want %q", tc.inst, got, tc.want)
+		}
+	}
+}
+
+func TestInstallationDetail(t *testing.T) {
+	for _, tc := range []struct {
+		fixture string
+		owner   bool
+	}{
+		{"installation_owner.json", true},
+		{"installation_member.json", false},
+	} {
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/": fixture(t, tc.fixture),
+		})
+
+		v := login(t, s)
+		d, err := v.InstallationDetail(context.Background())
+		s.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tc.fixture, err)
+			continue
+		}
+		if d.GIID != "1" || d.OwnerName != "Anna Svensson" || d.IsOwner != tc.owner {
+			t.Errorf("%s: %+v", tc.fixture, d)
+		}
+		if tc.owner && (d.OwnerEmail != "anna@example.com" || d.Created.Year() != 2019) {
+			t.Errorf("%s: owner details %+v", tc.fixture, d)
+		}
+		if !tc.owner && (d.OwnerEmail != "" || !d.Created.IsZero()) {
+			t.Errorf("%s: partial details %+v", tc.fixture, d)
+		}
+	}
+}
+
+func TestMalformedInstallationSkipped(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search": fixture(t, "installations_mixed.json"),
+	})
+	defer s.Close()
+
+	var events []LogEvent
+	v := login(t, s, WithLogger(func(e LogEvent) { events = append(events, e) }))
+
+	insts := v.Installations()
+	if len(insts) != 2 || insts[0].GIID != "1" || insts[1].GIID != "3" {
+		t.Fatalf("installations %+v", insts)
+	}
+	if insts[0].FirmwareVersion != 12 || insts[1].Shard != 1 || insts[0].Address() != "Storgatan 12" {
+		t.Errorf("installations %+v", insts)
+	}
+	if inst, err := v.ActiveInstallation(); err != nil || inst.GIID != "1" {
+		t.Errorf("active installation %+v, %v", inst, err)
+	}
+	if len(events) != 1 || events[0].Type != LogInstallationSkipped || events[0].ErrorClass != "decode" {
+		t.Errorf("log events %+v", events)
+	}
+}
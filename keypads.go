@@ -0,0 +1,66 @@
+package verisure
+
+import (
+	"context"
+	"time"
+)
+
+// Keypad status. LastUsed is zero if the panel has not reported a use.
+type Keypad struct {
+	DeviceLabel string
+	Area        string
+	Battery     string
+	Online      bool
+	LastUsed    time.Time
+}
+
+// Tag is an RFID tag used to arm and disarm at a keypad
+type Tag struct {
+	DeviceLabel string
+	Battery     string
+	Online      bool
+	LastUsed    time.Time
+}
+
+// Keypads ...
+func (v *Verisure) Keypads(ctx context.Context) ([]Keypad, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := make([]Keypad, 0)
+	for _, d := range ds {
+		if d.DeviceType == DeviceTypeKeypad {
+			ks = append(ks, Keypad{
+				DeviceLabel: d.DeviceLabel,
+				Area:        d.Area,
+				Battery:     d.Battery,
+				Online:      d.Online,
+				LastUsed:    d.LastUsed})
+		}
+	}
+
+	return ks, nil
+}
+
+// Tags ...
+func (v *Verisure) Tags(ctx context.Context) ([]Tag, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := make([]Tag, 0)
+	for _, d := range ds {
+		if d.DeviceType == DeviceTypeTag {
+			ts = append(ts, Tag{
+				DeviceLabel: d.DeviceLabel,
+				Battery:     d.Battery,
+				Online:      d.Online,
+				LastUsed:    d.LastUsed})
+		}
+	}
+
+	return ts, nil
+}
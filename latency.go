@@ -0,0 +1,61 @@
+package verisure
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HostUnreachable is the latency MeasureHosts gives hosts that failed
+const HostUnreachable = time.Duration(1<<63 - 1)
+
+// MeasureHosts probes every configured host at once and returns each one's
+// response time. Hosts that cannot be reached or fail with a server error
+// get HostUnreachable.
+func (v *Verisure) MeasureHosts(ctx context.Context) (map[string]time.Duration, error) {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+		ls = make(map[string]time.Duration, len(v.baseURLs))
+	)
+	for _, host := range v.baseURLs {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+
+			start := time.Now()
+			l := HostUnreachable
+			if err := v.probe(ctx, host); err == nil {
+				l = time.Since(start)
+			}
+
+			mu.Lock()
+			ls[host] = l
+			mu.Unlock()
+		}(host)
+	}
+	wg.Wait()
+
+	return ls, ctx.Err()
+}
+
+// UseFastestHost measures the hosts and sends following requests to the
+// fastest reachable one first. It does nothing if no host is reachable.
+func (v *Verisure) UseFastestHost(ctx context.Context) error {
+	ls, err := v.MeasureHosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	best, fastest := "", HostUnreachable
+	for _, host := range v.baseURLs {
+		if ls[host] < fastest {
+			best, fastest = host, ls[host]
+		}
+	}
+	if best != "" {
+		v.setHost(best)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package verisure
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// DeviceTypeWater is the type prefix of water leak detectors
+const DeviceTypeWater = "WATER"
+
+// LeakDetector status. LastEvent is zero if none of the latest events came
+// from the detector.
+type LeakDetector struct {
+	DeviceLabel string
+	Area        string
+	Alarm       bool
+	Online      bool
+	Battery     string
+	LastEvent   time.Time
+}
+
+// LeakDetectors returns every water leak detector, an empty slice if the
+// installation has none
+func (v *Verisure) LeakDetectors(ctx context.Context) ([]LeakDetector, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lds := make([]LeakDetector, 0)
+	opts := EventOptions{PageSize: defaultEventPageSize}
+	for _, d := range ds {
+		if !strings.HasPrefix(d.DeviceType, DeviceTypeWater) {
+			continue
+		}
+		lds = append(lds, LeakDetector{
+			DeviceLabel: d.DeviceLabel,
+			Area:        d.Area,
+			Alarm:       d.Alarm,
+			Online:      d.Online,
+			Battery:     d.Battery})
+		opts = opts.WithDevice(d.DeviceLabel)
+	}
+	if len(lds) == 0 {
+		return lds, nil
+	}
+
+	es, err := v.Events(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	last := make(map[string]time.Time, len(lds))
+	for _, e := range es {
+		if e.EventTime.After(last[e.DeviceLabel]) {
+			last[e.DeviceLabel] = e.EventTime
+		}
+	}
+	for i := range lds {
+		lds[i].LastEvent = last[lds[i].DeviceLabel]
+	}
+
+	return lds, nil
+}
@@ -0,0 +1,44 @@
+package verisure
+
+import "io"
+
+// defaultMaxResponseBytes bounds response bodies unless configured
+const defaultMaxResponseBytes = 10 << 20
+
+// WithMaxResponseBytes limits how much of a response body is read. Larger
+// bodies fail with ErrResponseTooLarge. n <= 0 removes the limit.
+func WithMaxResponseBytes(n int64) Option {
+	return func(v *Verisure) {
+		v.maxResponseBytes = n
+	}
+}
+
+// limitBody wraps a response body in the configured size limit
+func (v *Verisure) limitBody(body io.ReadCloser) io.ReadCloser {
+	if v.maxResponseBytes <= 0 {
+		return body
+	}
+
+	return &limitedBody{ReadCloser: body, n: v.maxResponseBytes}
+}
+
+// limitedBody fails once more than n bytes have been read
+type limitedBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	// One byte past the limit tells an oversized body from one that fits
+	if l.n < int64(len(p)) {
+		p = p[:l.n+1]
+	}
+
+	n, err := l.ReadCloser.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, ErrResponseTooLarge
+	}
+
+	return n, err
+}
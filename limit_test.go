@@ -0,0 +1,33 @@
+package verisure
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMaxResponseBytes(t *testing.T) {
+	body := `{"armState":{"statusType":"DISARMED"},"padding":"` + strings.Repeat("x", 100) + `"}`
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(body),
+	})
+	defer s.Close()
+
+	for _, tc := range []struct {
+		limit int64
+		err   error
+	}{
+		{10, ErrResponseTooLarge},
+		{int64(len(body)), nil},
+		{0, nil},
+		{math.MaxInt64, nil},
+	} {
+		v := login(t, s)
+		WithMaxResponseBytes(tc.limit)(v)
+		if _, err := v.Overview(context.Background()); err != tc.err {
+			t.Errorf("limit %d: got %v, want %v", tc.limit, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package verisure
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// listenInterval between event log polls while listening
+var listenInterval = 10 * time.Second
+
+// Size of the pool running event handlers and of its queue
+const (
+	listenWorkers = 4
+	listenQueue   = 64
+)
+
+var errListening = errors.New("verisure: already listening")
+
+type listener struct {
+	mu       sync.Mutex
+	handlers []func(Event)
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+type dispatch struct {
+	handler func(Event)
+	event   Event
+}
+
+// OnEvent registers handler to be called with every new event while
+// listening. Handlers run concurrently on a small pool of goroutines, so
+// they must be safe for concurrent use and should return quickly.
+func (v *Verisure) OnEvent(handler func(Event)) {
+	l := &v.shared().listener
+	l.mu.Lock()
+	l.handlers = append(l.handlers, handler)
+	l.mu.Unlock()
+}
+
+// StartListening polls the event log until ctx is done or StopListening is
+// called, passing each new event to every OnEvent handler, oldest first.
+// Events already in the log when listening starts are not passed on.
+// Polling only waits for handlers when the queue of pending calls is full.
+// Once stopped, listening can be started again.
+func (v *Verisure) StartListening(ctx context.Context) error {
+	l := &v.shared().listener
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cancel != nil {
+		return errListening
+	}
+
+	ctx, l.cancel = context.WithCancel(ctx)
+	l.done = make(chan struct{})
+	go v.listen(ctx, l.done)
+
+	return nil
+}
+
+// StopListening stops polling and waits for running handlers to return
+func (v *Verisure) StopListening() {
+	l := &v.shared().listener
+	l.mu.Lock()
+	cancel, done := l.cancel, l.done
+	l.cancel, l.done = nil, nil
+	l.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (v *Verisure) listen(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	defer v.shared().listener.stopped(done)
+
+	queue := make(chan dispatch, listenQueue)
+	var wg sync.WaitGroup
+	for i := 0; i < listenWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range queue {
+				d.handler(d.event)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(queue)
+
+	var seen map[string]bool
+	for {
+		es, ids, err := v.newEvents(ctx, seen)
+		if err == nil {
+			if !v.dispatch(ctx, queue, es) {
+				return
+			}
+			seen = ids
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(v.jitter(listenInterval)):
+		}
+	}
+}
+
+// stopped clears the listening state once the listen loop with done has
+// returned by itself, so that StartListening can be called again
+func (l *listener) stopped(done chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.done == done {
+		l.cancel()
+		l.cancel, l.done = nil, nil
+	}
+}
+
+// newEvents pages back from the newest event until it reaches one in seen
+// or the end of the log, returning the events before it newest first along
+// with the IDs of the newest page. With seen nil only the newest page is
+// fetched, and no events are returned.
+func (v *Verisure) newEvents(ctx context.Context, seen map[string]bool) ([]Event, map[string]bool, error) {
+	var (
+		es     []Event
+		newest map[string]bool
+		got    = make(map[string]bool)
+	)
+	opts := EventOptions{PageSize: defaultEventPageSize}
+	for {
+		page, err := v.Events(ctx, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		if newest == nil {
+			newest = make(map[string]bool, len(page))
+			for _, e := range page {
+				newest[e.EventID] = true
+			}
+			if seen == nil {
+				return nil, newest, nil
+			}
+		}
+
+		for _, e := range page {
+			if seen[e.EventID] {
+				return es, newest, nil
+			}
+			// Events arriving while paging shift older ones onto the next page
+			if !got[e.EventID] {
+				got[e.EventID] = true
+				es = append(es, e)
+			}
+		}
+		if len(page) < opts.PageSize {
+			return es, newest, nil
+		}
+		opts.Offset += len(page)
+	}
+}
+
+// dispatch queues the newest first events es for every handler, oldest
+// first, reporting false if ctx ended first
+func (v *Verisure) dispatch(ctx context.Context, queue chan<- dispatch, es []Event) bool {
+	l := &v.shared().listener
+	l.mu.Lock()
+	handlers := append(([]func(Event))(nil), l.handlers...)
+	l.mu.Unlock()
+
+	for i := len(es) - 1; i >= 0; i-- {
+		for _, h := range handlers {
+			select {
+			case queue <- dispatch{h, es[i]}:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+	return true
+}
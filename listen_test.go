@@ -0,0 +1,141 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListen(t *testing.T) {
+	defer func(d time.Duration) { listenInterval = d }(listenInterval)
+	listenInterval = 10 * time.Millisecond
+
+	var polls int32
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/eventlog": func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&polls, 1) == 1 {
+				w.Write([]byte(`{"eventLogItems":[{"eventId":"old"}]}`))
+				return
+			}
+			w.Write([]byte(`{"eventLogItems":[{"eventId":"new"},{"eventId":"old"}]}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	var (
+		mu  sync.Mutex
+		got []string
+		wg  sync.WaitGroup
+	)
+	wg.Add(2)
+	for _, name := range []string{"a", "b"} {
+		name := name
+		v.OnEvent(func(e Event) {
+			mu.Lock()
+			got = append(got, name+":"+e.EventID)
+			mu.Unlock()
+			wg.Done()
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := v.StartListening(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.StartListening(ctx); err != errListening {
+		t.Errorf("second StartListening: %v", err)
+	}
+	wg.Wait()
+	cancel()
+
+	sort.Strings(got)
+	if len(got) != 2 || got[0] != "a:new" || got[1] != "b:new" {
+		t.Errorf("handled %v", got)
+	}
+
+	// Once the listen loop has noticed ctx ended listening can start again
+	deadline := time.Now().Add(time.Second)
+	for {
+		err := v.StartListening(context.Background())
+		if err == nil {
+			break
+		}
+		if err != errListening || time.Now().After(deadline) {
+			t.Fatalf("StartListening after ctx ended: %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	v.StopListening()
+}
+
+func TestListenPagesBack(t *testing.T) {
+	defer func(d time.Duration) { listenInterval = d }(listenInterval)
+	listenInterval = 10 * time.Millisecond
+
+	// After the first poll 60 events arrive, more than fit on one page
+	var polls int32
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/eventlog": func(w http.ResponseWriter, r *http.Request) {
+			log := []string{`{"eventId":"old"}`}
+			if r.URL.Query().Get("offset") != "0" || atomic.AddInt32(&polls, 1) > 1 {
+				log = nil
+				for i := 59; i >= 0; i-- {
+					log = append(log, fmt.Sprintf(`{"eventId":"%d"}`, i))
+				}
+				log = append(log, `{"eventId":"old"}`)
+			}
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			size, _ := strconv.Atoi(r.URL.Query().Get("pagesize"))
+			if offset > len(log) {
+				offset = len(log)
+			}
+			if offset+size < len(log) {
+				log = log[offset : offset+size]
+			} else {
+				log = log[offset:]
+			}
+			fmt.Fprintf(w, `{"eventLogItems":[%s]}`, strings.Join(log, ","))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	var (
+		mu  sync.Mutex
+		got = make(map[string]int)
+		wg  sync.WaitGroup
+	)
+	wg.Add(60)
+	v.OnEvent(func(e Event) {
+		mu.Lock()
+		got[e.EventID]++
+		mu.Unlock()
+		wg.Done()
+	})
+
+	if err := v.StartListening(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	time.Sleep(5 * listenInterval)
+	v.StopListening()
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 0; i < 60; i++ {
+		if n := got[strconv.Itoa(i)]; n != 1 {
+			t.Errorf("event %d handled %d times", i, n)
+		}
+	}
+	if len(got) != 60 {
+		t.Errorf("handled %d distinct events, want 60", len(got))
+	}
+}
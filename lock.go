@@ -0,0 +1,93 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DoorLock generated
+type DoorLock struct {
+	DeviceLabel      string    `json:"deviceLabel"`
+	Area             string    `json:"area"`
+	Method           string    `json:"method"`
+	LockedState      string    `json:"lockedState"`
+	CurrentLockState string    `json:"currentLockState"`
+	PendingLockState string    `json:"pendingLockState"`
+	EventTime        time.Time `json:"eventTime"`
+	SecureModeActive bool      `json:"secureModeActive"`
+	MotorJam         bool      `json:"motorJam"`
+	Paired           bool      `json:"paired"`
+}
+
+type doorLockCommand struct {
+	Code string `json:"code"`
+}
+
+type doorLockTransaction struct {
+	ID string `json:"doorLockStateChangeTransactionId"`
+}
+
+// SetDoorLock locks or unlocks a smart lock and waits for the panel to
+// accept the change
+func (v *Verisure) SetDoorLock(ctx context.Context, deviceLabel, code string, lock bool) error {
+	if err := v.checkCode(code); err != nil {
+		return err
+	}
+
+	action := "unlock"
+	if lock {
+		action = "lock"
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	var t doorLockTransaction
+	path := fmt.Sprintf("/installation/%s/device/%s/%s", giid, url.PathEscape(deviceLabel), action)
+	if err := v.call(ctx, "doorlock", http.MethodPut, path, doorLockCommand{code}, &t); err != nil {
+		return err
+	}
+
+	path = fmt.Sprintf("/installation/%s/doorlockstate/change/result/%s", giid, t.ID)
+	return v.waitTransaction(ctx, "doorlock", path)
+}
+
+// LockEvent is a smart lock being locked or unlocked. Method is how, such
+// as "CODE", "APP", "THUMB" or "AUTO"; UserName is empty when no user was
+// involved.
+type LockEvent struct {
+	DeviceLabel string
+	Locked      bool
+	UserName    string
+	Method      string
+	Time        time.Time
+}
+
+// DoorLockHistory returns up to limit of a smart lock's latest lock and
+// unlock events, newest first. limit 0 returns all of them.
+func (v *Verisure) DoorLockHistory(ctx context.Context, deviceLabel string, limit int) ([]LockEvent, error) {
+	opts := EventOptions{Limit: limit}.
+		WithCategories(EventDoorLockLocked, EventDoorLockUnlocked).
+		WithDevice(deviceLabel)
+	es, err := v.allEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ls := make([]LockEvent, 0, len(es))
+	for _, e := range es {
+		ls = append(ls, LockEvent{
+			DeviceLabel: e.DeviceLabel,
+			Locked:      e.is(EventDoorLockLocked),
+			UserName:    e.UserName,
+			Method:      e.Method,
+			Time:        e.EventTime})
+	}
+
+	return ls, nil
+}
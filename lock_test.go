@@ -0,0 +1,26 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDoorLockHistory(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/eventlog": ok(`{"eventLogItems":[
+			{"eventCategory":"DOORLOCK_UNLOCKED","deviceLabel":"L1","userName":"Anna","method":"CODE"},
+			{"eventType":"DOORLOCK_LOCKED","deviceLabel":"L1","method":"AUTO"},
+			{"eventCategory":"DOORLOCK_LOCKED","deviceLabel":"L1","method":"THUMB"}]}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ls, err := v.DoorLockHistory(context.Background(), "L1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 3 || ls[0].Locked || !ls[1].Locked || !ls[2].Locked || ls[0].UserName != "Anna" {
+		t.Errorf("history %+v", ls)
+	}
+}
@@ -0,0 +1,76 @@
+package verisure
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// LogEvent describes something noteworthy the client did. It never holds
+// credentials or request URLs, only the API hosts involved.
+type LogEvent struct {
+	Type       string
+	Host       string
+	NextHost   string
+	ErrorClass string
+}
+
+// Log event types
+const (
+	LogFailover            = "failover"
+	LogInstallationSkipped = "installation skipped"
+)
+
+// Logger receives the client's log events
+type Logger func(LogEvent)
+
+// WithLogger sets a Logger for diagnostic events such as host failover
+func WithLogger(l Logger) Option {
+	return func(v *Verisure) {
+		v.logger = l
+	}
+}
+
+func (v *Verisure) logFailover(host, next string, err error) {
+	if v.logger == nil {
+		return
+	}
+
+	v.logger(LogEvent{
+		Type:       LogFailover,
+		Host:       host,
+		NextHost:   next,
+		ErrorClass: errorClass(err)})
+}
+
+func (v *Verisure) logSkipped(err error) {
+	if v.logger == nil {
+		return
+	}
+
+	v.logger(LogEvent{
+		Type:       LogInstallationSkipped,
+		Host:       v.host(),
+		ErrorClass: errorClass(err)})
+}
+
+// errorClass summarizes err without its message, which may embed URLs
+// with query parameters such as the username.
+func errorClass(err error) string {
+	switch e := err.(type) {
+	case *statusError:
+		return "status " + e.status
+	case *url.Error:
+		if e.Timeout() {
+			return "timeout"
+		}
+		return "network"
+	}
+	if err == ErrPermissionDenied {
+		return "permission"
+	}
+	if _, ok := err.(*json.UnmarshalTypeError); ok {
+		return "decode"
+	}
+
+	return "other"
+}
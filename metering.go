@@ -0,0 +1,49 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PowerMetering reported by a smart plug
+type PowerMetering struct {
+	DeviceLabel string
+	Watts       float64
+	KWh         float64
+	Time        time.Time
+}
+
+type powerMeteringJSON struct {
+	MeteringSupported bool      `json:"meteringSupported"`
+	PowerConsumption  flexFloat `json:"powerConsumption"`
+	EnergyConsumption flexFloat `json:"energyConsumption"`
+	Time              time.Time `json:"time"`
+}
+
+// SmartplugMetering returns the current power draw and cumulative energy
+// of a smart plug. Plugs without metering give ErrNotSupported.
+func (v *Verisure) SmartplugMetering(ctx context.Context, deviceLabel string) (PowerMetering, error) {
+	m := PowerMetering{DeviceLabel: deviceLabel}
+	giid, err := v.giid()
+	if err != nil {
+		return m, err
+	}
+
+	var j powerMeteringJSON
+	path := fmt.Sprintf("/installation/%s/smartplug/%s/metering", giid, url.PathEscape(deviceLabel))
+	if err := v.call(ctx, "smartplug metering", http.MethodGet, path, nil, &j); err != nil {
+		return m, err
+	}
+	if !j.MeteringSupported {
+		return m, ErrNotSupported
+	}
+
+	m.Watts = float64(j.PowerConsumption)
+	m.KWh = float64(j.EnergyConsumption)
+	m.Time = j.Time
+
+	return m, nil
+}
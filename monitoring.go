@@ -0,0 +1,97 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MonitoringInfo of the installation's professional monitoring
+type MonitoringInfo struct {
+	Monitored           bool   `json:"monitored"`
+	ResponseCenter      string `json:"responseCenterName"`
+	ResponseCenterPhone string `json:"responseCenterPhone"`
+	ServiceLevel        string `json:"serviceLevel"`
+}
+
+// MonitoringInfo reports whether a response center monitors the
+// installation. Self-monitored installations give a zero MonitoringInfo.
+func (v *Verisure) MonitoringInfo(ctx context.Context) (MonitoringInfo, error) {
+	var m MonitoringInfo
+	giid, err := v.giid()
+	if err != nil {
+		return m, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/cps", giid)
+	if err := v.call(ctx, "monitoring", http.MethodGet, path, nil, &m); err != nil {
+		if isNotFound(err) {
+			return MonitoringInfo{}, nil
+		}
+		return m, err
+	}
+
+	return m, nil
+}
+
+// GuardWindow is a period, From to To in "15:04" local time, during which
+// guards respond to alarms. To before From means the window ends the next
+// day.
+type GuardWindow struct {
+	Day  time.Weekday
+	From string
+	To   string
+}
+
+// GuardService of the installation. Self-monitored installations have it
+// disabled.
+type GuardService struct {
+	Enabled  bool
+	Active   bool
+	Schedule []GuardWindow
+}
+
+type guardServiceJSON struct {
+	GuardService struct {
+		Enabled  bool `json:"enabled"`
+		Active   bool `json:"active"`
+		Schedule []struct {
+			Day  string `json:"day"`
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"schedule"`
+	} `json:"guardService"`
+}
+
+// GuardService reports whether guard response is active now and when it
+// is scheduled
+func (v *Verisure) GuardService(ctx context.Context) (GuardService, error) {
+	var s GuardService
+	giid, err := v.giid()
+	if err != nil {
+		return s, err
+	}
+
+	var j guardServiceJSON
+	path := fmt.Sprintf("/installation/%s/cps", giid)
+	if err := v.call(ctx, "guard service", http.MethodGet, path, nil, &j); err != nil {
+		if isNotFound(err) {
+			return s, nil
+		}
+		return s, err
+	}
+
+	g := j.GuardService
+	s.Enabled, s.Active = g.Enabled, g.Enabled && g.Active
+	for _, w := range g.Schedule {
+		day, ok := weekdays[strings.ToUpper(w.Day)]
+		if !ok {
+			return s, fmt.Errorf("guard service: day %q", w.Day)
+		}
+		s.Schedule = append(s.Schedule, GuardWindow{day, w.From, w.To})
+	}
+
+	return s, nil
+}
@@ -0,0 +1,87 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MotionZone is a rectangle of the camera frame, in pixels from the top
+// left corner, in which motion triggers detection
+type MotionZone struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// MotionZones of a camera along with its frame size
+type MotionZones struct {
+	FrameWidth  int          `json:"frameWidth"`
+	FrameHeight int          `json:"frameHeight"`
+	Supported   bool         `json:"zonesSupported"`
+	Zones       []MotionZone `json:"zones"`
+}
+
+// Validate checks every zone lies within the frame
+func (m MotionZones) Validate() error {
+	for i, z := range m.Zones {
+		if z.X < 0 || z.Y < 0 || z.Width <= 0 || z.Height <= 0 ||
+			z.X+z.Width > m.FrameWidth || z.Y+z.Height > m.FrameHeight {
+			return fmt.Errorf("motion zones: zone %d outside %dx%d frame", i, m.FrameWidth, m.FrameHeight)
+		}
+	}
+	return nil
+}
+
+func (v *Verisure) motionZonesPath(deviceLabel string) (string, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/installation/%s/device/%s/camera/motionzones", giid, url.PathEscape(deviceLabel)), nil
+}
+
+// CameraMotionZones returns the regions that trigger motion detection on
+// a camera. Cameras without zone support give ErrNotSupported.
+func (v *Verisure) CameraMotionZones(ctx context.Context, deviceLabel string) (MotionZones, error) {
+	var m MotionZones
+	path, err := v.motionZonesPath(deviceLabel)
+	if err != nil {
+		return m, err
+	}
+
+	if err := v.call(ctx, "motion zones", http.MethodGet, path, nil, &m); err != nil {
+		if isNotFound(err) {
+			return m, ErrNotSupported
+		}
+		return m, err
+	}
+	if !m.Supported {
+		return m, ErrNotSupported
+	}
+
+	return m, nil
+}
+
+// SetCameraMotionZones replaces a camera's motion zones. Zones are checked
+// against the camera's frame size before anything is sent.
+func (v *Verisure) SetCameraMotionZones(ctx context.Context, deviceLabel string, zones []MotionZone) error {
+	m, err := v.CameraMotionZones(ctx, deviceLabel)
+	if err != nil {
+		return err
+	}
+
+	m.Zones = zones
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	path, err := v.motionZonesPath(deviceLabel)
+	if err != nil {
+		return err
+	}
+
+	return v.call(ctx, "motion zones", http.MethodPut, path, m, nil)
+}
@@ -0,0 +1,45 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Panel connection types
+const (
+	ConnectionWiFi     = "WIFI"
+	ConnectionEthernet = "ETHERNET"
+)
+
+// WiFiConfig of a panel connected over Wi-Fi. Signal ranges from NoSignal
+// to FullSignal.
+type WiFiConfig struct {
+	SSID   string `json:"ssid"`
+	Signal int    `json:"signalLevel"`
+}
+
+// NetworkConfig of the panel. WiFi is nil for panels without Wi-Fi.
+type NetworkConfig struct {
+	ConnectionType string      `json:"connectionType"`
+	WiFi           *WiFiConfig `json:"wifi"`
+}
+
+// NetworkConfig returns how the panel connects to the internet
+func (v *Verisure) NetworkConfig(ctx context.Context) (NetworkConfig, error) {
+	var c NetworkConfig
+	giid, err := v.giid()
+	if err != nil {
+		return c, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/networkconfig", giid)
+	if err := v.call(ctx, "network config", http.MethodGet, path, nil, &c); err != nil {
+		return c, err
+	}
+	if c.WiFi != nil && c.WiFi.SSID == "" {
+		c.WiFi = nil
+	}
+
+	return c, nil
+}
@@ -0,0 +1,52 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NotificationToggle selects the channels used for one kind of event
+type NotificationToggle struct {
+	Push bool `json:"push"`
+	SMS  bool `json:"sms"`
+}
+
+// NotificationSettings of the logged in user
+type NotificationSettings struct {
+	Alarm     NotificationToggle `json:"alarm"`
+	Fire      NotificationToggle `json:"fire"`
+	Arm       NotificationToggle `json:"arm"`
+	Disarm    NotificationToggle `json:"disarm"`
+	DoorLock  NotificationToggle `json:"doorLock"`
+	Technical NotificationToggle `json:"technical"`
+}
+
+// NotificationSettings ...
+func (v *Verisure) NotificationSettings(ctx context.Context) (NotificationSettings, error) {
+	var s NotificationSettings
+	giid, err := v.giid()
+	if err != nil {
+		return s, err
+	}
+
+	path := fmt.Sprintf("/installation/%s/notificationsettings", giid)
+	err = v.call(ctx, "notification settings", http.MethodGet, path, nil, &s)
+	return s, err
+}
+
+// SetNotificationSettings replaces the logged in user's settings. Only
+// installation owners may do this, others get ErrPermissionDenied.
+func (v *Verisure) SetNotificationSettings(ctx context.Context, s NotificationSettings) error {
+	if err := v.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/notificationsettings", giid)
+	return v.call(ctx, "notification settings", http.MethodPut, path, s, nil)
+}
@@ -0,0 +1,38 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSetNotificationSettingsOwnerOnly(t *testing.T) {
+	for _, tc := range []struct {
+		detail string
+		err    error
+		put    bool
+	}{
+		{"installation_member.json", ErrPermissionDenied, false},
+		{"installation_owner.json", nil, true},
+	} {
+		put := false
+		s := newAPI(map[string]http.HandlerFunc{
+			// An admin who is not the owner must still be refused
+			"GET /installation/1/permissions": ok(`{"permissions":["ARM","DISARM","ADMIN"]}`),
+			"GET /installation/1/":            fixture(t, tc.detail),
+			"PUT /installation/1/notificationsettings": func(w http.ResponseWriter, r *http.Request) {
+				put = true
+			},
+		})
+
+		v := login(t, s)
+		ns := NotificationSettings{Alarm: NotificationToggle{Push: true}}
+		if err := v.SetNotificationSettings(context.Background(), ns); err != tc.err {
+			t.Errorf("%s: got %v, want %v", tc.detail, err, tc.err)
+		}
+		if put != tc.put {
+			t.Errorf("%s: PUT sent = %v", tc.detail, put)
+		}
+		s.Close()
+	}
+}
@@ -0,0 +1,56 @@
+package verisure
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Option configures a client created by New
+type Option func(*Verisure)
+
+// WithBaseURLs replaces the API hosts Login tries, in order
+func WithBaseURLs(urls ...string) Option {
+	return func(v *Verisure) {
+		v.baseURLs = make([]string, 0, len(urls))
+		for _, u := range urls {
+			v.baseURLs = append(v.baseURLs, strings.TrimRight(u, "/"))
+		}
+	}
+}
+
+// WithShard pins the client to the API host serving the given shard, as
+// reported in an installation's Shard field. Unknown shards make Login fail.
+func WithShard(shard int) Option {
+	return func(v *Verisure) {
+		if shard < 0 || shard >= len(apiURLs) {
+			v.err = fmt.Errorf("verisure: unknown shard %d", shard)
+			return
+		}
+		v.shard = shard
+		v.baseURLs = []string{apiURLs[shard]}
+	}
+}
+
+// WithLocale sets the locale of the installation, used together with
+// WithSkipInstallationLookup
+func WithLocale(locale string) Option {
+	return func(v *Verisure) {
+		v.locale = locale
+	}
+}
+
+// WithSkipInstallationLookup makes Login target the installation with the
+// given GIID instead of searching for the user's installations. Combine it
+// with WithShard and WithLocale to describe the installation fully.
+func WithSkipInstallationLookup(giid string) Option {
+	return func(v *Verisure) {
+		v.skipLookup = giid
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(ua string) Option {
+	return func(v *Verisure) {
+		v.userAgent = ua
+	}
+}
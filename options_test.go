@@ -0,0 +1,39 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithShard(t *testing.T) {
+	a := newAPI(map[string]http.HandlerFunc{"POST /cookie": reply(http.StatusInternalServerError, "")})
+	defer a.Close()
+	b := newAPI(nil)
+	defer b.Close()
+
+	defer func(urls []string) { apiURLs = urls }(apiURLs)
+	apiURLs = []string{a.URL, b.URL}
+
+	v := New(WithShard(1), WithSkipInstallationLookup("9"), WithLocale("sv_SE"))
+	if len(v.baseURLs) != 1 || v.baseURLs[0] != b.URL {
+		t.Fatalf("base URLs %v, want %s", v.baseURLs, b.URL)
+	}
+	if err := v.Login(context.Background(), "user@example.com", "password"); err != nil {
+		t.Fatal(err)
+	}
+	inst, err := v.ActiveInstallation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inst.GIID != "9" || inst.Shard != 1 || inst.Locale != "sv_SE" || v.host() != b.URL {
+		t.Errorf("installation %+v on %s", inst, v.host())
+	}
+
+	for _, shard := range []int{-1, 2} {
+		v := New(WithShard(shard))
+		if err := v.Login(context.Background(), "user@example.com", "password"); err == nil {
+			t.Errorf("shard %d: Login succeeded", shard)
+		}
+	}
+}
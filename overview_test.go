@@ -0,0 +1,65 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestEventCount(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": fixture(t, "overview_eventcounts.json"),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	o, err := v.Overview(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for category, want := range map[string]int{
+		"MOTION":              5,
+		EventDoorWindowOpened: 12,
+		"INTRUSION":           0,
+		"FIRE":                0,
+	} {
+		if got := o.EventCount(category); got != want {
+			t.Errorf("%s: %d, want %d", category, got, want)
+		}
+	}
+}
+
+func TestOverviewSectionsArmStateOnly(t *testing.T) {
+	var query string
+	s := newAPI(map[string]http.HandlerFunc{
+		// An older host ignoring the selection and sending everything
+		"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query().Get("sections")
+			w.Write([]byte(`{
+				"armState": {"statusType": "ARMED_AWAY"},
+				"armstateCompatible": true,
+				"smartPlugs": [{"deviceLabel": "SP1"}],
+				"climateValues": [{"deviceLabel": "CL1", "temperature": 21}]
+			}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	o, err := v.OverviewSections(context.Background(), SectionArmState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != string(SectionArmState) {
+		t.Errorf("sections requested %q", query)
+	}
+	if o.ArmState.StatusType != string(ArmArmedAway) {
+		t.Errorf("arm state %+v", o.ArmState)
+	}
+	if o.ArmstateCompatible || len(o.SmartPlugs) != 0 || len(o.ClimateValues) != 0 {
+		t.Errorf("unrequested sections kept: %+v", o)
+	}
+	if _, ok := o.RawSections["smartPlugs"]; ok {
+		t.Error("unrequested raw section kept")
+	}
+}
@@ -0,0 +1,44 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type panelTime struct {
+	Time flexTime `json:"panelTime"`
+}
+
+// PanelTime returns the panel's current date and time
+func (v *Verisure) PanelTime(ctx context.Context) (time.Time, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var t panelTime
+	path := fmt.Sprintf("/installation/%s/panel/time", giid)
+	if err := v.call(ctx, "panel time", http.MethodGet, path, nil, &t); err != nil {
+		return time.Time{}, err
+	}
+	if time.Time(t.Time).IsZero() {
+		return time.Time{}, fmt.Errorf("panel time: not reported")
+	}
+
+	return time.Time(t.Time), nil
+}
+
+// ClockSkew reports how far the panel's clock is ahead of the local clock,
+// negative if it is behind. Half the request's round trip is allowed for.
+func (v *Verisure) ClockSkew(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	t, err := v.PanelTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(start)
+
+	return t.Sub(start.Add(rtt / 2)), nil
+}
@@ -0,0 +1,49 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPanelTime(t *testing.T) {
+	for _, tc := range []struct {
+		body string
+		want time.Time
+		err  bool
+	}{
+		{`{"panelTime":"2026-01-01T08:30:15.000Z"}`, time.Date(2026, 1, 1, 8, 30, 15, 0, time.UTC), false},
+		{`{"panelTime":"2026-01-01T09:30:15+01:00"}`, time.Date(2026, 1, 1, 8, 30, 15, 0, time.UTC), false},
+		{`{"panelTime":""}`, time.Time{}, true},
+		{`{}`, time.Time{}, true},
+		{`{"panelTime":"noon"}`, time.Time{}, true},
+	} {
+		s := newAPI(map[string]http.HandlerFunc{"GET /installation/1/panel/time": ok(tc.body)})
+		v := login(t, s)
+		got, err := v.PanelTime(context.Background())
+		s.Close()
+		if (err != nil) != tc.err || !got.Equal(tc.want) {
+			t.Errorf("%s: got %v, %v", tc.body, got, err)
+		}
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/panel/time": func(w http.ResponseWriter, r *http.Request) {
+			ahead := time.Now().Add(time.Hour).UTC().Format(time.RFC3339Nano)
+			w.Write([]byte(`{"panelTime":"` + ahead + `"}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	skew, err := v.ClockSkew(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := skew - time.Hour; d < -time.Second || d > time.Second {
+		t.Errorf("skew %v, want about an hour", skew)
+	}
+}
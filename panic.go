@@ -0,0 +1,49 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PanicType of an SOS alarm. The zero value is not a valid type, so a
+// panic is never sent by accident.
+type PanicType string
+
+// Panic types
+const (
+	PanicIntrusion PanicType = "INTRUSION"
+	PanicFire      PanicType = "FIRE"
+	PanicMedical   PanicType = "MEDICAL"
+)
+
+type panicCommand struct {
+	Type PanicType `json:"panicType"`
+}
+
+// TriggerPanic raises an SOS alarm. This is a real alarm: the sirens sound
+// and the monitoring center acts on it, which may mean dispatching guards,
+// police, fire brigade or an ambulance. Installations that do not support
+// panic from the app give ErrNotSupported.
+func (v *Verisure) TriggerPanic(ctx context.Context, panicType PanicType) error {
+	switch panicType {
+	case PanicIntrusion, PanicFire, PanicMedical:
+	default:
+		return fmt.Errorf("panic: invalid type %q", panicType)
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/panic", giid)
+	if err := v.call(ctx, "panic", http.MethodPost, path, panicCommand{panicType}, nil); err != nil {
+		if isNotFound(err) {
+			return ErrNotSupported
+		}
+		return err
+	}
+
+	return nil
+}
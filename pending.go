@@ -0,0 +1,36 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PendingChange is a state change the panel has not yet applied
+type PendingChange struct {
+	DeviceLabel  string `json:"deviceLabel"`
+	DeviceType   string `json:"deviceType"`
+	Area         string `json:"area"`
+	CurrentState string `json:"currentState"`
+	PendingState string `json:"pendingState"`
+}
+
+// PendingChanges details what Overview.PendingChanges counts. An empty
+// slice is returned when nothing is pending.
+func (v *Verisure) PendingChanges(ctx context.Context) ([]PendingChange, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []PendingChange
+	path := fmt.Sprintf("/installation/%s/pendingchanges", giid)
+	if err := v.call(ctx, "pending changes", http.MethodGet, path, nil, &cs); err != nil {
+		return nil, err
+	}
+	if cs == nil {
+		cs = []PendingChange{}
+	}
+
+	return cs, nil
+}
@@ -0,0 +1,101 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Permissions of the logged in user on the installation
+type Permissions struct {
+	Hash           string
+	CanArm         bool
+	CanDisarm      bool
+	CanAdmin       bool
+	CanViewCameras bool
+}
+
+type permissionsJSON struct {
+	AccountPermissionsHash string   `json:"accountPermissionsHash"`
+	Permissions            []string `json:"permissions"`
+}
+
+// Permissions resolves what the logged in user may do on the selected
+// installation. The result is cached per installation until the next Login.
+func (v *Verisure) Permissions(ctx context.Context) (Permissions, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return Permissions{}, err
+	}
+	v.shared().mu.Lock()
+	p, ok := v.permissions[giid]
+	v.shared().mu.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	var j permissionsJSON
+	path := fmt.Sprintf("/installation/%s/permissions", giid)
+	if err := v.call(ctx, "permissions", http.MethodGet, path, nil, &j); err != nil {
+		return Permissions{}, err
+	}
+
+	p = Permissions{Hash: j.AccountPermissionsHash}
+	for _, s := range j.Permissions {
+		switch s {
+		case "ARM":
+			p.CanArm = true
+		case "DISARM":
+			p.CanDisarm = true
+		case "ADMIN":
+			p.CanAdmin = true
+		case "CAMERA_VIEW":
+			p.CanViewCameras = true
+		}
+	}
+	v.shared().mu.Lock()
+	if v.permissions == nil {
+		v.permissions = make(map[string]Permissions)
+	}
+	v.permissions[giid] = p
+	v.shared().mu.Unlock()
+
+	return p, nil
+}
+
+// ConfigHash returns the account permissions hash without fetching the
+// overview. The hash changes along with the account configuration, so
+// pollers can compare it to decide when a full refresh is due. A changed
+// hash also drops the cached Permissions.
+func (v *Verisure) ConfigHash(ctx context.Context) (string, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return "", err
+	}
+
+	var j permissionsJSON
+	path := fmt.Sprintf("/installation/%s/permissions", giid)
+	if err := v.call(ctx, "config hash", http.MethodGet, path, nil, &j); err != nil {
+		return "", err
+	}
+	v.shared().mu.Lock()
+	if p, ok := v.permissions[giid]; ok && p.Hash != j.AccountPermissionsHash {
+		delete(v.permissions, giid)
+	}
+	v.shared().mu.Unlock()
+
+	return j.AccountPermissionsHash, nil
+}
+
+// requireOwner fails with ErrPermissionDenied unless the logged in user
+// owns the selected installation. Admin permission alone is not enough.
+func (v *Verisure) requireOwner(ctx context.Context) error {
+	d, err := v.InstallationDetail(ctx)
+	if err != nil {
+		return err
+	}
+	if !d.IsOwner {
+		return ErrPermissionDenied
+	}
+	return nil
+}
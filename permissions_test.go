@@ -0,0 +1,80 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPermissionsPerInstallation(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search":        ok(`[{"giid":"1"},{"giid":"2"}]`),
+		"GET /installation/1/permissions": ok(`{"accountPermissionsHash":"a","permissions":["ARM","ADMIN"]}`),
+		"GET /installation/2/permissions": ok(`{"accountPermissionsHash":"b","permissions":["ARM"]}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	for _, giid := range []string{"1", "2", "1"} {
+		if err := v.SelectInstallation(giid); err != nil {
+			t.Fatal(err)
+		}
+		p, err := v.Permissions(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := giid == "1"; p.CanAdmin != want {
+			t.Errorf("installation %s: CanAdmin = %v", giid, p.CanAdmin)
+		}
+	}
+}
+
+func TestConfigHashDropsPermissions(t *testing.T) {
+	var fetches int32
+	var hash atomic.Value
+	hash.Store("a")
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/permissions": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&fetches, 1)
+			w.Write([]byte(`{"accountPermissionsHash":"` + hash.Load().(string) + `","permissions":["ARM"]}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Permissions(ctx); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := v.ConfigHash(ctx); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	hash.Store("b")
+	if h, err := v.ConfigHash(ctx); err != nil || h != "b" {
+		t.Fatalf("ConfigHash = %q, %v", h, err)
+	}
+	before := atomic.LoadInt32(&fetches)
+	p, err := v.Permissions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Hash != "b" || atomic.LoadInt32(&fetches) != before+1 {
+		t.Errorf("permissions %+v not refetched after hash change", p)
+	}
+}
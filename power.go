@@ -0,0 +1,42 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PowerStatus of the panel. BatteryLevel is the backup battery charge in
+// percent, Changed when the panel last switched between mains and battery.
+type PowerStatus struct {
+	OnMains      bool
+	BatteryLevel int
+	Changed      time.Time
+}
+
+type panelStatus struct {
+	MainsPower   bool     `json:"mainsPowerConnected"`
+	BatteryLevel flexInt  `json:"backupBatteryLevel"`
+	Changed      flexTime `json:"powerStateChangedTime"`
+}
+
+// PowerStatus reports whether the panel runs on mains power or on its
+// backup battery
+func (v *Verisure) PowerStatus(ctx context.Context) (PowerStatus, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return PowerStatus{}, err
+	}
+
+	var s panelStatus
+	path := fmt.Sprintf("/installation/%s/panel/status", giid)
+	if err := v.call(ctx, "power status", http.MethodGet, path, nil, &s); err != nil {
+		return PowerStatus{}, err
+	}
+
+	return PowerStatus{
+		OnMains:      s.MainsPower,
+		BatteryLevel: int(s.BatteryLevel),
+		Changed:      time.Time(s.Changed)}, nil
+}
@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PresenceStatus of a tracked user
+type PresenceStatus string
+
+// Presence states. Users that do not share their location are unknown.
+const (
+	PresenceHome    PresenceStatus = "HOME"
+	PresenceAway    PresenceStatus = "AWAY"
+	PresenceUnknown PresenceStatus = "UNKNOWN"
+)
+
+// UserPresence of a user tracked by the installation
+type UserPresence struct {
+	Name           string         `json:"name"`
+	Status         PresenceStatus `json:"currentLocationName"`
+	LastUpdate     time.Time      `json:"currentLocationTimestamp"`
+	TrackingActive bool           `json:"userTrackingActive"`
+}
+
+// Presence returns whether each tracked user is home or away
+func (v *Verisure) Presence(ctx context.Context) ([]UserPresence, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var ps []UserPresence
+	path := fmt.Sprintf("/installation/%s/userTracking", giid)
+	if err := v.call(ctx, "presence", http.MethodGet, path, nil, &ps); err != nil {
+		return nil, err
+	}
+
+	for i := range ps {
+		if !ps[i].TrackingActive || (ps[i].Status != PresenceHome && ps[i].Status != PresenceAway) {
+			ps[i].Status = PresenceUnknown
+		}
+	}
+
+	return ps, nil
+}
@@ -0,0 +1,82 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithRateLimit spaces out requests to at most rps per second on average,
+// allowing bursts of up to burst requests. Requests wait for their turn
+// until their context is done. rps 0 or less disables the limit.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(v *Verisure) {
+		if rps <= 0 {
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+
+		l := &limiter{rate: rps, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+		v.middleware = append(v.middleware, func(rt http.RoundTripper) http.RoundTripper {
+			return &rateLimited{rt, l}
+		})
+	}
+}
+
+// limiter is a token bucket
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// wait takes a token, waiting for one to be refilled if necessary
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type rateLimited struct {
+	next    http.RoundTripper
+	limiter *limiter
+}
+
+func (r *rateLimited) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := r.limiter.wait(req.Context()); err != nil {
+		// RoundTrippers must close the body even when failing
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return r.next.RoundTrip(req)
+}
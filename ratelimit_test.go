@@ -0,0 +1,104 @@
+package verisure
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitSpacing(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			times = append(times, time.Now())
+			mu.Unlock()
+			w.Write([]byte(`{}`))
+		},
+	})
+	defer s.Close()
+
+	const rps = 20
+	v := login(t, s, WithRateLimit(rps, 1))
+	for i := 0; i < 5; i++ {
+		if _, err := v.Overview(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Allow for timer slack, but not for requests going out back to back
+	min := time.Second / rps * 8 / 10
+	for i := 1; i < len(times); i++ {
+		if d := times[i].Sub(times[i-1]); d < min {
+			t.Errorf("request %d after %v, want at least %v", i, d, min)
+		}
+	}
+}
+
+func TestRateLimitBurstAndCancel(t *testing.T) {
+	l := &limiter{rate: 10, burst: 3, tokens: 3, last: time.Now()}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d > 50*time.Millisecond {
+		t.Errorf("burst took %v", d)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v, want DeadlineExceeded", err)
+	}
+
+	// The cancelled wait gave its token back, so the next one is due in
+	// about one interval
+	start = time.Now()
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 150*time.Millisecond {
+		t.Errorf("wait after cancel took %v", d)
+	}
+}
+
+// closeCounter is a request body counting Close calls
+type closeCounter struct {
+	io.Reader
+	closes int
+}
+
+func (c *closeCounter) Close() error {
+	c.closes++
+	return nil
+}
+
+func TestRateLimitClosesBodyOnCancel(t *testing.T) {
+	rt := &rateLimited{
+		next:    http.DefaultTransport,
+		limiter: &limiter{rate: 1, burst: 1, last: time.Now()},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	body := &closeCounter{Reader: strings.NewReader("{}")}
+	req, err := http.NewRequest(http.MethodPost, "http://example.com/", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req.WithContext(ctx)); err != context.Canceled {
+		t.Errorf("got %v, want Canceled", err)
+	}
+	if body.closes != 1 {
+		t.Errorf("body closed %d times, want once", body.closes)
+	}
+}
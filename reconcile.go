@@ -0,0 +1,18 @@
+package verisure
+
+// IsReconciling reports whether the plug has been told to switch but has
+// not yet done so. An empty PendingState means nothing is pending.
+func (s SmartPlug) IsReconciling() bool {
+	return s.PendingState != "" && s.PendingState != s.CurrentState
+}
+
+// ReconcilingPlugs returns the smart plugs that are switching
+func (o Overview) ReconcilingPlugs() []SmartPlug {
+	var ps []SmartPlug
+	for _, p := range o.SmartPlugs {
+		if p.IsReconciling() {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
@@ -0,0 +1,36 @@
+package verisure
+
+import "testing"
+
+func TestIsReconciling(t *testing.T) {
+	for _, tc := range []struct {
+		current, pending string
+		want             bool
+	}{
+		{"ON", "ON", false},
+		{"OFF", "ON", true},
+		{"ON", "OFF", true},
+		{"ON", "", false},
+		{"", "", false},
+	} {
+		p := SmartPlug{CurrentState: tc.current, PendingState: tc.pending}
+		if got := p.IsReconciling(); got != tc.want {
+			t.Errorf("current %q pending %q: %v", tc.current, tc.pending, got)
+		}
+	}
+}
+
+func TestReconcilingPlugs(t *testing.T) {
+	o := Overview{SmartPlugs: []SmartPlug{
+		{DeviceLabel: "SP1", CurrentState: "ON", PendingState: "ON"},
+		{DeviceLabel: "SP2", CurrentState: "OFF", PendingState: "ON"},
+		{DeviceLabel: "SP3", CurrentState: "ON"},
+	}}
+	ps := o.ReconcilingPlugs()
+	if len(ps) != 1 || ps[0].DeviceLabel != "SP2" {
+		t.Errorf("reconciling %+v", ps)
+	}
+	if ps := (Overview{}).ReconcilingPlugs(); len(ps) != 0 {
+		t.Errorf("empty overview: %+v", ps)
+	}
+}
@@ -0,0 +1,128 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ArmReminder reminds users to arm at Hour:Minute on the given days if the
+// alarm is still disarmed
+type ArmReminder struct {
+	Days    []time.Weekday
+	Hour    int
+	Minute  int
+	Enabled bool
+}
+
+type armReminderJSON struct {
+	Days    []string `json:"days"`
+	Time    string   `json:"time"`
+	Enabled bool     `json:"enabled"`
+}
+
+// MarshalJSON encodes days as upper case names and the time as "15:04"
+func (r ArmReminder) MarshalJSON() ([]byte, error) {
+	j := armReminderJSON{
+		Days:    make([]string, 0, len(r.Days)),
+		Time:    fmt.Sprintf("%02d:%02d", r.Hour, r.Minute),
+		Enabled: r.Enabled}
+	for _, d := range r.Days {
+		j.Days = append(j.Days, strings.ToUpper(d.String()))
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes the API's day names and "15:04" time
+func (r *ArmReminder) UnmarshalJSON(data []byte) error {
+	var j armReminderJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	t, err := time.Parse("15:04", j.Time)
+	if err != nil {
+		return fmt.Errorf("arm reminder: time %q", j.Time)
+	}
+	r.Hour, r.Minute, r.Enabled = t.Hour(), t.Minute(), j.Enabled
+
+	r.Days = r.Days[:0]
+	for _, name := range j.Days {
+		d, ok := weekdays[strings.ToUpper(name)]
+		if !ok {
+			return fmt.Errorf("arm reminder: day %q", name)
+		}
+		r.Days = append(r.Days, d)
+	}
+
+	return nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"SUNDAY":    time.Sunday,
+	"MONDAY":    time.Monday,
+	"TUESDAY":   time.Tuesday,
+	"WEDNESDAY": time.Wednesday,
+	"THURSDAY":  time.Thursday,
+	"FRIDAY":    time.Friday,
+	"SATURDAY":  time.Saturday,
+}
+
+// Validate checks the reminder has days and a valid time of day
+func (r ArmReminder) Validate() error {
+	if len(r.Days) == 0 {
+		return fmt.Errorf("arm reminder: no days")
+	}
+	if r.Hour < 0 || r.Hour > 23 || r.Minute < 0 || r.Minute > 59 {
+		return fmt.Errorf("arm reminder: invalid time %02d:%02d", r.Hour, r.Minute)
+	}
+	return nil
+}
+
+// ArmReminders returns the installation's arm reminders, an empty slice if
+// there are none
+func (v *Verisure) ArmReminders(ctx context.Context) ([]ArmReminder, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var rs []ArmReminder
+	path := fmt.Sprintf("/installation/%s/armreminders", giid)
+	if err := v.call(ctx, "arm reminders", http.MethodGet, path, nil, &rs); err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	if rs == nil {
+		rs = []ArmReminder{}
+	}
+
+	return rs, nil
+}
+
+// SetArmReminders replaces the installation's arm reminders. Only the
+// installation owner may change them.
+func (v *Verisure) SetArmReminders(ctx context.Context, rs []ArmReminder) error {
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := v.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+	if rs == nil {
+		rs = []ArmReminder{}
+	}
+
+	path := fmt.Sprintf("/installation/%s/armreminders", giid)
+	return v.call(ctx, "arm reminders", http.MethodPut, path, rs, nil)
+}
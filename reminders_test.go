@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSetArmRemindersOwnerOnly(t *testing.T) {
+	rs := []ArmReminder{{Days: []time.Weekday{time.Monday}, Hour: 22, Minute: 30, Enabled: true}}
+	for _, tc := range []struct {
+		detail string
+		err    error
+		body   string
+	}{
+		{"installation_member.json", ErrPermissionDenied, ""},
+		{"installation_owner.json", nil, `[{"days":["MONDAY"],"time":"22:30","enabled":true}]`},
+	} {
+		var (
+			mu   sync.Mutex
+			body string
+		)
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/permissions": ok(`{"permissions":["ARM","ADMIN"]}`),
+			"GET /installation/1/":            fixture(t, tc.detail),
+			"PUT /installation/1/armreminders": func(w http.ResponseWriter, r *http.Request) {
+				bs, _ := ioutil.ReadAll(r.Body)
+				mu.Lock()
+				body = string(bs)
+				mu.Unlock()
+			},
+		})
+
+		v := login(t, s)
+		if err := v.SetArmReminders(context.Background(), rs); err != tc.err {
+			t.Errorf("%s: got %v, want %v", tc.detail, err, tc.err)
+		}
+		mu.Lock()
+		if body != tc.body {
+			t.Errorf("%s: sent %q, want %q", tc.detail, body, tc.body)
+		}
+		mu.Unlock()
+		s.Close()
+	}
+}
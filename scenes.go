@@ -0,0 +1,72 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Scene is a named group of actions, such as locking doors and arming
+type Scene struct {
+	ID      string   `json:"sceneId"`
+	Name    string   `json:"name"`
+	Actions []string `json:"actions"`
+	Arms    bool     `json:"containsArming"`
+}
+
+type runSceneCommand struct {
+	Code string `json:"code,omitempty"`
+}
+
+// Scenes returns the account's scenes. Accounts without scene support
+// give ErrNotSupported.
+func (v *Verisure) Scenes(ctx context.Context) ([]Scene, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var ss []Scene
+	path := fmt.Sprintf("/installation/%s/scenes", giid)
+	if err := v.call(ctx, "scenes", http.MethodGet, path, nil, &ss); err != nil {
+		if isNotFound(err) {
+			return nil, ErrNotSupported
+		}
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+// RunScene runs the scene with the given ID. Scenes that arm the alarm
+// need the PIN code, for others code may be empty.
+func (v *Verisure) RunScene(ctx context.Context, sceneID, code string) error {
+	ss, err := v.Scenes(ctx)
+	if err != nil {
+		return err
+	}
+
+	var scene *Scene
+	for i := range ss {
+		if ss[i].ID == sceneID {
+			scene = &ss[i]
+		}
+	}
+	if scene == nil {
+		return fmt.Errorf("run scene: unknown scene %q", sceneID)
+	}
+	if scene.Arms || code != "" {
+		if err := v.checkCode(code); err != nil {
+			return err
+		}
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/scenes/%s/run", giid, url.PathEscape(sceneID))
+	return v.call(ctx, "run scene", http.MethodPost, path, runSceneCommand{code}, nil)
+}
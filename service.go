@@ -0,0 +1,101 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ServiceState of the Verisure API
+type ServiceState int
+
+// Service states
+const (
+	ServiceOperational ServiceState = iota
+	ServiceDegraded
+	ServiceMaintenance
+)
+
+func (s ServiceState) String() string {
+	switch s {
+	case ServiceOperational:
+		return "operational"
+	case ServiceDegraded:
+		return "degraded"
+	case ServiceMaintenance:
+		return "maintenance"
+	}
+	return "unknown"
+}
+
+// ServiceStatus of the API. Message is the API's explanation, if any, and
+// Down the hosts that did not respond normally.
+type ServiceStatus struct {
+	State   ServiceState
+	Message string
+	Down    []string
+}
+
+// ServiceStatus probes every configured host without logging in. The
+// service is in maintenance when no host is up and at least one says so,
+// and degraded when any host is down.
+func (v *Verisure) ServiceStatus(ctx context.Context) (ServiceStatus, error) {
+	var s ServiceStatus
+	maintenance := false
+	for _, host := range v.baseURLs {
+		err := v.probe(ctx, host)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return s, ctx.Err()
+		}
+
+		s.Down = append(s.Down, host)
+		if se, ok := err.(*statusError); ok {
+			if isMaintenance(se) {
+				maintenance = true
+			}
+			if s.Message == "" {
+				s.Message = se.message
+			}
+		}
+	}
+
+	switch {
+	case len(s.Down) == 0:
+		s.State = ServiceOperational
+	case maintenance && len(s.Down) == len(v.baseURLs):
+		s.State = ServiceMaintenance
+	default:
+		s.State = ServiceDegraded
+	}
+
+	return s, nil
+}
+
+// probe reports an error if host fails with a server error. Client errors
+// such as 401 or 404 mean the host is up.
+func (v *Verisure) probe(ctx context.Context, host string) error {
+	req, err := v.newRequest(http.MethodGet, host+"/", nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusInternalServerError {
+		return nil
+	}
+	return checkStatus("service status", res)
+}
+
+func isMaintenance(se *statusError) bool {
+	return se.code == http.StatusServiceUnavailable &&
+		(strings.Contains(strings.ToUpper(se.errorCode), "MAINTENANCE") ||
+			strings.Contains(strings.ToLower(se.message), "maintenance"))
+}
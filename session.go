@@ -0,0 +1,169 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// sessionCookie is the name of the cookie holding the API session
+const sessionCookie = "vid"
+
+// recordSession remembers when the session cookie set by a login response
+// expires. The cookie jar keeps cookies' expiry to itself.
+func (v *Verisure) recordSession(res *http.Response) {
+	v.sessionExpiry = time.Time{}
+	for _, c := range res.Cookies() {
+		if c.Name != sessionCookie {
+			continue
+		}
+		switch {
+		case c.MaxAge > 0:
+			v.sessionExpiry = time.Now().Add(time.Duration(c.MaxAge) * time.Second)
+		case !c.Expires.IsZero():
+			v.sessionExpiry = c.Expires
+		}
+	}
+}
+
+// SessionValidUntil reports when the current session expires, so it can be
+// renewed with Login before requests start failing. It returns false when
+// there is no session. A zero time means the session has no set expiry.
+func (v *Verisure) SessionValidUntil() (time.Time, bool) {
+	if t := v.currentToken(); t != nil {
+		return t.Expiry, true
+	}
+
+	u, err := url.Parse(v.host())
+	if err != nil || v.client.Jar == nil {
+		return time.Time{}, false
+	}
+	for _, c := range v.client.Jar.Cookies(u) {
+		if c.Name == sessionCookie {
+			if !v.sessionExpiry.IsZero() && time.Now().After(v.sessionExpiry) {
+				return v.sessionExpiry, false
+			}
+			return v.sessionExpiry, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// clearSession drops the session cookies, so a later Login does not send a
+// stale session. The client's own jar is replaced; in a jar that came with
+// WithHTTPClient the cookies for the API hosts are expired at every path
+// they may have been set for.
+func (v *Verisure) clearSession() {
+	v.sessionExpiry = time.Time{}
+	if v.client.Jar == nil {
+		return
+	}
+	if !v.customClient {
+		if jar, err := cookiejar.New(nil); err == nil {
+			v.client.Jar = jar
+			return
+		}
+	}
+
+	for _, host := range v.baseURLs {
+		u, err := url.Parse(host)
+		if err != nil {
+			continue
+		}
+		var expired []*http.Cookie
+		for _, c := range v.client.Jar.Cookies(u) {
+			for _, p := range cookiePaths(u.Path) {
+				expired = append(expired, &http.Cookie{Name: c.Name, Path: p, MaxAge: -1})
+			}
+		}
+		if len(expired) > 0 {
+			v.client.Jar.SetCookies(u, expired)
+		}
+	}
+}
+
+// cookiePaths lists the paths a cookie sent to path can be scoped to: "/"
+// and each of path's prefixes
+func cookiePaths(path string) []string {
+	path = strings.TrimRight(path, "/")
+	paths := []string{"/"}
+	for i := 1; i < len(path); i++ {
+		if path[i] == '/' {
+			paths = append(paths, path[:i])
+		}
+	}
+	if path != "" {
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// Session is the state needed to resume a login, including the selected
+// installation. It can be stored as JSON and contains credentials.
+type Session struct {
+	Username string         `json:"username"`
+	Host     string         `json:"host"`
+	Token    *Token         `json:"token,omitempty"`
+	Cookies  []*http.Cookie `json:"cookies,omitempty"`
+	Expiry   time.Time      `json:"expiry"`
+	GIID     string         `json:"giid"`
+}
+
+// SaveSession returns the current session for LoadSession
+func (v *Verisure) SaveSession() Session {
+	s := Session{
+		Username: v.username,
+		Host:     v.host(),
+		Expiry:   v.sessionExpiry,
+		GIID:     v.selected}
+	if t := v.currentToken(); t != nil {
+		tt := *t
+		s.Token = &tt
+	}
+	if u, err := url.Parse(s.Host); err == nil && v.client.Jar != nil {
+		s.Cookies = v.client.Jar.Cookies(u)
+	}
+
+	return s
+}
+
+// LoadSession resumes a session from SaveSession instead of logging in,
+// fetching the installations again. If the saved installation is gone the
+// first one is selected and ErrInstallationGone is returned; the session is
+// usable nonetheless.
+func (v *Verisure) LoadSession(ctx context.Context, s Session) error {
+	if v.err != nil {
+		return v.err
+	}
+
+	v.permissions = nil
+	v.subscription = nil
+	v.eventCategories = nil
+	v.armStates = nil
+	v.armCompat = nil
+	v.username = s.Username
+	v.sessionExpiry = s.Expiry
+	v.token = s.Token
+	v.setHost(s.Host)
+	if u, err := url.Parse(s.Host); err == nil && v.client.Jar != nil && len(s.Cookies) > 0 {
+		cs := make([]*http.Cookie, 0, len(s.Cookies))
+		for _, c := range s.Cookies {
+			cs = append(cs, &http.Cookie{Name: c.Name, Value: c.Value, Path: "/"})
+		}
+		v.client.Jar.SetCookies(u, cs)
+	}
+
+	v.selected = s.GIID
+	if err := v.installation(ctx, s.Username); err != nil {
+		return err
+	}
+	if s.GIID != "" && v.selected != s.GIID {
+		return ErrInstallationGone
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+)
+
+func TestLoginLogoutLogin(t *testing.T) {
+	jar, _ := cookiejar.New(nil)
+	for _, tc := range []struct {
+		name string
+		opts []Option
+	}{
+		{"own jar", nil},
+		{"custom client", []Option{WithHTTPClient(&http.Client{Jar: jar})}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stale := 0
+			s := newAPI(map[string]http.HandlerFunc{
+				// No Path, so the cookie is scoped to /xbn/2
+				"POST /xbn/2/cookie": func(w http.ResponseWriter, r *http.Request) {
+					if _, err := r.Cookie(sessionCookie); err == nil {
+						stale++
+					}
+					http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: "session"})
+				},
+				"DELETE /xbn/2/cookie":           ok(""),
+				"GET /xbn/2/installation/search": ok(`[{"giid":"1"}]`),
+			})
+			defer s.Close()
+
+			v := New(append([]Option{WithBaseURLs(s.URL + "/xbn/2")}, tc.opts...)...)
+			ctx := context.Background()
+			for i := 0; i < 2; i++ {
+				if err := v.Login(ctx, "user@example.com", "password"); err != nil {
+					t.Fatal(err)
+				}
+				if _, ok := v.SessionValidUntil(); !ok {
+					t.Fatal("no session after Login")
+				}
+				if err := v.Logout(ctx); err != nil {
+					t.Fatal(err)
+				}
+				if _, ok := v.SessionValidUntil(); ok {
+					t.Fatal("session still valid after Logout")
+				}
+			}
+			if stale != 0 {
+				t.Errorf("%d logins sent a stale session cookie", stale)
+			}
+		})
+	}
+}
+
+func TestTokenLogoutClearsCookies(t *testing.T) {
+	s := tokenAPI(map[string]http.HandlerFunc{
+		"GET /installation/search": func(w http.ResponseWriter, r *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: "lb", Value: "node1"})
+			w.Write([]byte(`[{"giid":"1"}]`))
+		},
+		"DELETE /auth/logout": ok(""),
+	})
+	defer s.Close()
+
+	v := login(t, s, WithAuthURL(s.URL+"/auth"))
+	if err := v.Logout(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(s.URL + "/installation")
+	if cs := v.client.Jar.Cookies(u); len(cs) != 0 {
+		t.Errorf("cookies after Logout: %v", cs)
+	}
+}
+
+func TestLogoutTwice(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"DELETE /cookie": func(w http.ResponseWriter, r *http.Request) {
+			if _, err := r.Cookie(sessionCookie); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := v.Logout(ctx); err != nil {
+			t.Fatalf("logout %d: %v", i+1, err)
+		}
+	}
+}
+
+func TestLogoutFailure(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"DELETE /cookie": reply(http.StatusBadRequest, `{}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	if err := v.Logout(context.Background()); err == nil {
+		t.Error("failed logout reported success")
+	}
+}
+
+func TestSaveLoadSession(t *testing.T) {
+	giids := `[{"giid":"1"},{"giid":"2"},{"giid":"3"}]`
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search": func(w http.ResponseWriter, r *http.Request) {
+			if _, err := r.Cookie(sessionCookie); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(giids))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	if err := v.SelectInstallation("2"); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := json.Marshal(v.SaveSession())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	load := func() (Verisure, error) {
+		var saved Session
+		if err := json.Unmarshal(bs, &saved); err != nil {
+			t.Fatal(err)
+		}
+		fresh := New(WithBaseURLs(s.URL))
+		return fresh, fresh.LoadSession(context.Background(), saved)
+	}
+
+	fresh, err := load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inst, err := fresh.ActiveInstallation(); err != nil || inst.GIID != "2" {
+		t.Errorf("active installation %+v, %v", inst, err)
+	}
+
+	giids = `[{"giid":"1"},{"giid":"3"}]`
+	fresh, err = load()
+	if err != ErrInstallationGone {
+		t.Errorf("got %v, want ErrInstallationGone", err)
+	}
+	if inst, err := fresh.ActiveInstallation(); err != nil || inst.GIID != "1" {
+		t.Errorf("fallback installation %+v, %v", inst, err)
+	}
+}
@@ -0,0 +1,49 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Signal levels range from NoSignal to FullSignal. Wireless devices below
+// DegradedSignal are reported as degraded.
+const (
+	NoSignal       = 0
+	DegradedSignal = 2
+	FullSignal     = 5
+)
+
+// DeviceSignal is the link quality between a device and the panel. Wired
+// devices have no signal level and are never degraded.
+type DeviceSignal struct {
+	DeviceLabel string `json:"deviceLabel"`
+	Area        string `json:"area"`
+	Wired       bool   `json:"wired"`
+	Level       int    `json:"signalLevel"`
+	Degraded    bool   `json:"-"`
+}
+
+// SignalStatus ...
+func (v *Verisure) SignalStatus(ctx context.Context) ([]DeviceSignal, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var ss []DeviceSignal
+	path := fmt.Sprintf("/installation/%s/communication/signal", giid)
+	if err := v.call(ctx, "signal status", http.MethodGet, path, nil, &ss); err != nil {
+		return nil, err
+	}
+
+	for i := range ss {
+		if ss[i].Wired {
+			ss[i].Level = NoSignal
+			continue
+		}
+		ss[i].Degraded = ss[i].Level < DegradedSignal
+	}
+
+	return ss, nil
+}
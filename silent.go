@@ -0,0 +1,68 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// silentModeTimeout bounds how long SetSilentMode waits for the change
+var silentModeTimeout = 30 * time.Second
+
+type notificationConfig struct {
+	SilentMode bool `json:"silentMode"`
+}
+
+// SilentMode reports whether push notifications and sirens for
+// non-critical events are suppressed
+func (v *Verisure) SilentMode(ctx context.Context) (bool, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return false, err
+	}
+
+	var c notificationConfig
+	path := fmt.Sprintf("/installation/%s/notificationconfig", giid)
+	err = v.call(ctx, "silent mode", http.MethodGet, path, nil, &c)
+	return c.SilentMode, err
+}
+
+// SetSilentMode turns silent mode on or off and waits until the
+// installation reports the change, giving ErrStateNotConfirmed if it does
+// not in time. Only the installation owner may change it.
+func (v *Verisure) SetSilentMode(ctx context.Context, on bool) error {
+	if err := v.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/notificationconfig", giid)
+	if err := v.call(ctx, "silent mode", http.MethodPut, path, notificationConfig{on}, nil); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(silentModeTimeout)
+	for {
+		cur, err := v.SilentMode(ctx)
+		if err != nil {
+			return err
+		}
+		if cur == on {
+			return nil
+		}
+		if time.Now().Add(confirmInterval).After(deadline) {
+			return ErrStateNotConfirmed
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(confirmInterval):
+		}
+	}
+}
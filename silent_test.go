@@ -0,0 +1,38 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSetSilentModeOwnerOnly(t *testing.T) {
+	for _, tc := range []struct {
+		detail string
+		err    error
+		puts   int32
+	}{
+		{"installation_member.json", ErrPermissionDenied, 0},
+		{"installation_owner.json", nil, 1},
+	} {
+		var puts int32
+		s := newAPI(map[string]http.HandlerFunc{
+			"GET /installation/1/permissions":        ok(`{"permissions":["ARM","ADMIN"]}`),
+			"GET /installation/1/":                   fixture(t, tc.detail),
+			"GET /installation/1/notificationconfig": ok(`{"silentMode":true}`),
+			"PUT /installation/1/notificationconfig": func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&puts, 1)
+			},
+		})
+
+		v := login(t, s)
+		if err := v.SetSilentMode(context.Background(), true); err != tc.err {
+			t.Errorf("%s: got %v, want %v", tc.detail, err, tc.err)
+		}
+		if n := atomic.LoadInt32(&puts); n != tc.puts {
+			t.Errorf("%s: %d changes sent, want %d", tc.detail, n, tc.puts)
+		}
+		s.Close()
+	}
+}
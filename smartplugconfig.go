@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SmartplugConfig is the icon of a smart plug and whether it powers
+// something hazardous, such as a heater
+type SmartplugConfig struct {
+	Icon      string `json:"icon"`
+	Hazardous bool   `json:"isHazardous"`
+}
+
+// SmartplugIcons returns the icons a smart plug may be given
+func (v *Verisure) SmartplugIcons(ctx context.Context) ([]string, error) {
+	giid, err := v.giid()
+	if err != nil {
+		return nil, err
+	}
+
+	var icons []string
+	path := fmt.Sprintf("/installation/%s/smartplug/icons", giid)
+	err = v.call(ctx, "smartplug icons", http.MethodGet, path, nil, &icons)
+	return icons, err
+}
+
+// SetSmartplugConfig changes a smart plug's icon and hazardous flag. The
+// icon must be one of SmartplugIcons.
+func (v *Verisure) SetSmartplugConfig(ctx context.Context, deviceLabel string, cfg SmartplugConfig) error {
+	icons, err := v.SmartplugIcons(ctx)
+	if err != nil {
+		return err
+	}
+	if !contains(icons, cfg.Icon) {
+		return fmt.Errorf("smartplug config: icon %q not allowed", cfg.Icon)
+	}
+
+	giid, err := v.giid()
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/installation/%s/smartplug/%s/config", giid, url.PathEscape(deviceLabel))
+	return v.call(ctx, "smartplug config", http.MethodPut, path, cfg, nil)
+}
@@ -0,0 +1,48 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSmartplugConfigWireFormat(t *testing.T) {
+	bs, err := json.Marshal(SmartplugConfig{Icon: "HEATER", Hazardous: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"icon":"HEATER","isHazardous":true}`; string(bs) != want {
+		t.Errorf("got %s, want %s", bs, want)
+	}
+}
+
+func TestSetSmartplugConfig(t *testing.T) {
+	var body string
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/smartplug/icons": ok(`["LAMP","HEATER"]`),
+		"PUT /installation/1/smartplug/ABCD EFGH/config": func(w http.ResponseWriter, r *http.Request) {
+			bs, _ := ioutil.ReadAll(r.Body)
+			body = string(bs)
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	if err := v.SetSmartplugConfig(ctx, "ABCD EFGH", SmartplugConfig{Icon: "LAMP"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"icon":"LAMP","isHazardous":false}`; body != want {
+		t.Errorf("sent %s, want %s", body, want)
+	}
+
+	body = ""
+	if err := v.SetSmartplugConfig(ctx, "ABCD EFGH", SmartplugConfig{Icon: "ROCKET"}); err == nil {
+		t.Error("unknown icon accepted")
+	}
+	if body != "" {
+		t.Errorf("sent %s for an unknown icon", body)
+	}
+}
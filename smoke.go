@@ -0,0 +1,62 @@
+package verisure
+
+import (
+	"context"
+	"strings"
+)
+
+// SmokeDetector status. Combined smoke and climate sensors also report a
+// temperature, HasTemperature tells them apart.
+type SmokeDetector struct {
+	DeviceLabel    string
+	Area           string
+	DeviceType     string
+	Alarm          bool
+	Online         bool
+	Battery        string
+	Temperature    float64
+	HasTemperature bool
+}
+
+// SmokeDetectors returns every smoke and heat detector
+func (v *Verisure) SmokeDetectors(ctx context.Context) ([]SmokeDetector, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := v.Overview(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	climate := make(map[string]ClimateValue, len(o.ClimateValues))
+	for _, c := range o.ClimateValues {
+		climate[c.DeviceLabel] = c
+	}
+
+	sds := make([]SmokeDetector, 0)
+	for _, d := range ds {
+		if !isSmokeDetector(d.DeviceType) {
+			continue
+		}
+
+		sd := SmokeDetector{
+			DeviceLabel: d.DeviceLabel,
+			Area:        d.Area,
+			DeviceType:  d.DeviceType,
+			Alarm:       d.Alarm,
+			Online:      d.Online,
+			Battery:     d.Battery}
+		if c, ok := climate[d.DeviceLabel]; ok {
+			sd.Temperature = c.Temperature
+			sd.HasTemperature = true
+		}
+		sds = append(sds, sd)
+	}
+
+	return sds, nil
+}
+
+func isSmokeDetector(deviceType string) bool {
+	return strings.HasPrefix(deviceType, DeviceTypeSmoke) || deviceType == DeviceTypeHeat
+}
@@ -0,0 +1,57 @@
+package verisure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Subscription of the installation and the features it includes
+type Subscription struct {
+	PlanName    string    `json:"planName"`
+	RenewalDate time.Time `json:"renewalDate"`
+	Features    []string  `json:"features"`
+}
+
+// HasFeature reports whether the subscription includes feature
+func (s Subscription) HasFeature(feature string) bool {
+	for _, f := range s.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription returns the installation's service plan. Only owners may
+// read it, others get ErrPermissionDenied. The result is cached per
+// installation until the next Login.
+func (v *Verisure) Subscription(ctx context.Context) (Subscription, error) {
+	var s Subscription
+	giid, err := v.giid()
+	if err != nil {
+		return s, err
+	}
+	v.shared().mu.Lock()
+	s, ok := v.subscription[giid]
+	v.shared().mu.Unlock()
+	if ok {
+		s.Features = append([]string(nil), s.Features...)
+		return s, nil
+	}
+
+	path := fmt.Sprintf("/installation/%s/subscription", giid)
+	if err := v.call(ctx, "subscription", http.MethodGet, path, nil, &s); err != nil {
+		return s, err
+	}
+	v.shared().mu.Lock()
+	if v.subscription == nil {
+		v.subscription = make(map[string]Subscription)
+	}
+	v.subscription[giid] = s
+	v.shared().mu.Unlock()
+
+	s.Features = append([]string(nil), s.Features...)
+	return s, nil
+}
@@ -0,0 +1,41 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestSubscriptionPerInstallation(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/search":         ok(`[{"giid":"1"},{"giid":"2"}]`),
+		"GET /installation/1/subscription": ok(`{"planName":"premium","features":["CAMERA"]}`),
+		"GET /installation/2/subscription": ok(`{"planName":"basic","features":[]}`),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx := context.Background()
+	for _, giid := range []string{"1", "2", "1"} {
+		if err := v.SelectInstallation(giid); err != nil {
+			t.Fatal(err)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sub, err := v.Subscription(ctx)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if want := giid == "1"; sub.HasFeature("CAMERA") != want {
+					t.Errorf("installation %s: %+v", giid, sub)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+}
@@ -0,0 +1,36 @@
+package verisure
+
+import (
+	"context"
+	"time"
+)
+
+// DeviceTamper is a device whose cover or mounting has been tampered with
+type DeviceTamper struct {
+	DeviceLabel string
+	DeviceType  string
+	Area        string
+	Time        time.Time
+}
+
+// TamperStatus returns the devices, including the panel, that currently
+// report tamper. An empty slice means nothing is tampered.
+func (v *Verisure) TamperStatus(ctx context.Context) ([]DeviceTamper, error) {
+	ds, err := v.Devices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := make([]DeviceTamper, 0)
+	for _, d := range ds {
+		if d.Tamper {
+			ts = append(ts, DeviceTamper{
+				DeviceLabel: d.DeviceLabel,
+				DeviceType:  d.DeviceType,
+				Area:        d.Area,
+				Time:        d.TamperTime})
+		}
+	}
+
+	return ts, nil
+}
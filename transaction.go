@@ -0,0 +1,100 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// pollInterval between checks of an asynchronous command's result
+var pollInterval = time.Second
+
+// TransactionResult of an asynchronous command. Result is "OK" once the
+// panel has carried out the command.
+type TransactionResult struct {
+	ID        string `json:"-"`
+	Result    string `json:"result"`
+	ErrorCode string `json:"errorCode"`
+}
+
+// waitTransaction polls path until the command it refers to has finished
+func (v *Verisure) waitTransaction(ctx context.Context, name, path string) error {
+	_, err := v.waitResult(ctx, name, path)
+	return err
+}
+
+// waitResult is waitTransaction returning the final result
+func (v *Verisure) waitResult(ctx context.Context, name, path string) (TransactionResult, error) {
+	for {
+		var t TransactionResult
+		if err := v.call(ctx, name, http.MethodGet, path, nil, &t); err != nil {
+			return t, err
+		}
+
+		switch t.Result {
+		case "OK":
+			return t, nil
+		case "NO_DATA", "":
+		default:
+			if t.ErrorCode == errWrongCode {
+				return t, ErrWrongCode
+			}
+			return t, fmt.Errorf("%s: %s %s", name, t.Result, t.ErrorCode)
+		}
+
+		select {
+		case <-ctx.Done():
+			return t, ctx.Err()
+		case <-time.After(v.jitter(pollInterval)):
+		}
+	}
+}
+
+// Command POSTs body to path, relative to the selected installation such
+// as "armstate/code", and waits for the command to finish like the
+// built-in commands do. The response must be a JSON object with a string
+// field named transactionId or ending in TransactionId, as in
+//
+//	{"armStateChangeTransactionId": "..."}
+//
+// The transaction is then polled at code/result/{id} until the panel
+// reports a result. Failed commands return the result along with an error.
+func (v *Verisure) Command(ctx context.Context, path string, body interface{}) (TransactionResult, error) {
+	var r TransactionResult
+	giid, err := v.giid()
+	if err != nil {
+		return r, err
+	}
+
+	var fields map[string]json.RawMessage
+	base := fmt.Sprintf("/installation/%s/", giid)
+	if err := v.call(ctx, "command", http.MethodPost, base+strings.TrimLeft(path, "/"), body, &fields); err != nil {
+		return r, err
+	}
+
+	id, err := transactionID(fields)
+	if err != nil {
+		return r, err
+	}
+
+	r, err = v.waitResult(ctx, "command", base+"code/result/"+id)
+	r.ID = id
+	return r, err
+}
+
+// transactionID finds the transaction ID in a command response
+func transactionID(fields map[string]json.RawMessage) (string, error) {
+	for name, raw := range fields {
+		if name != "transactionId" && !strings.HasSuffix(name, "TransactionId") {
+			continue
+		}
+		var id string
+		if err := json.Unmarshal(raw, &id); err == nil && id != "" {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("command: no transaction ID in response")
+}
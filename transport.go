@@ -0,0 +1,125 @@
+package verisure
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// WithTransport wraps the client's transport in middleware, for instance
+// for tracing or caching. Requests reaching it already carry cookies from
+// the client's jar and the package's headers. Middleware is applied in the
+// order given, so the last one added sees requests first.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(v *Verisure) {
+		v.middleware = append(v.middleware, wrap)
+	}
+}
+
+// buildTransport stacks the configured middleware on the base transport
+func (v *Verisure) buildTransport() {
+	if len(v.middleware) == 0 {
+		return
+	}
+
+	rt := v.client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for _, wrap := range v.middleware {
+		rt = wrap(rt)
+	}
+	v.client.Transport = rt
+}
+
+// WithHTTPClient makes the client send requests with c. A cookie jar is
+// added if c has none. It cannot be combined with WithTransportTuning or
+// WithRootCAs, which build their own transport; configure c's transport
+// instead.
+func WithHTTPClient(c *http.Client) Option {
+	return func(v *Verisure) {
+		if v.tuned {
+			v.err = errClientAndTuning
+			return
+		}
+
+		jar := v.client.Jar
+		v.client = *c
+		if v.client.Jar == nil {
+			v.client.Jar = jar
+		}
+		v.customClient = true
+	}
+}
+
+// WithTransportTuning sets up a transport with the given connection pool
+// limits, keeping the client's cookie jar. maxIdleConnsPerHost bounds the
+// idle connections kept per host, maxConnsPerHost all connections to a
+// host. As in http.Transport a zero maxIdleConnsPerHost means
+// http.DefaultMaxIdleConnsPerHost, while the other zero values mean no
+// limit. It cannot be combined with WithHTTPClient.
+func WithTransportTuning(maxIdleConns, maxIdleConnsPerHost, maxConnsPerHost int, idleTimeout time.Duration) Option {
+	return func(v *Verisure) {
+		if v.customClient {
+			v.err = errClientAndTuning
+			return
+		}
+
+		t := v.transport()
+		t.MaxIdleConns = maxIdleConns
+		t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		t.MaxConnsPerHost = maxConnsPerHost
+		t.IdleConnTimeout = idleTimeout
+		v.tuned = true
+	}
+}
+
+// WithRootCAs makes the client verify server certificates against pool
+// instead of the system roots, keeping the client's cookie jar. Like
+// WithTransportTuning it cannot be combined with WithHTTPClient.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(v *Verisure) {
+		if v.customClient {
+			v.err = errClientAndTuning
+			return
+		}
+
+		t := v.transport()
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{}
+		} else {
+			t.TLSClientConfig = t.TLSClientConfig.Clone()
+		}
+		t.TLSClientConfig.RootCAs = pool
+		v.tuned = true
+	}
+}
+
+var errClientAndTuning = errors.New("verisure: WithHTTPClient cannot be combined with WithTransportTuning or WithRootCAs")
+
+// transport returns the client's own *http.Transport, setting up one with
+// the same defaults as http.DefaultTransport if there is none yet
+func (v *Verisure) transport() *http.Transport {
+	if t, ok := v.client.Transport.(*http.Transport); ok {
+		return t
+	}
+
+	t := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	v.client.Transport = t
+
+	return t
+}
@@ -0,0 +1,118 @@
+package verisure
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithTransportTuning(t *testing.T) {
+	v := New(WithTransportTuning(10, 3, 2, 45*time.Second))
+	if v.err != nil {
+		t.Fatal(v.err)
+	}
+	tr, ok := v.client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("transport %T", v.client.Transport)
+	}
+	if tr.MaxIdleConns != 10 || tr.MaxIdleConnsPerHost != 3 || tr.MaxConnsPerHost != 2 || tr.IdleConnTimeout != 45*time.Second {
+		t.Errorf("transport %+v", tr)
+	}
+	if tr.Proxy == nil || tr.DialContext == nil || !tr.ForceAttemptHTTP2 {
+		t.Error("tuned transport lost the default proxy, dialer or HTTP/2")
+	}
+	if v.client.Jar == nil {
+		t.Error("cookie jar dropped")
+	}
+	if http.DefaultTransport.(*http.Transport).MaxConnsPerHost == 2 {
+		t.Error("tuning changed http.DefaultTransport")
+	}
+}
+
+func TestTuningAndHTTPClient(t *testing.T) {
+	for _, opts := range [][]Option{
+		{WithHTTPClient(&http.Client{}), WithTransportTuning(1, 1, 1, 0)},
+		{WithTransportTuning(1, 1, 1, 0), WithHTTPClient(&http.Client{})},
+		{WithHTTPClient(&http.Client{}), WithRootCAs(nil)},
+	} {
+		v := New(opts...)
+		if err := v.Login(context.Background(), "user@example.com", "password"); err != errClientAndTuning {
+			t.Errorf("got %v, want errClientAndTuning", err)
+		}
+	}
+}
+
+// counter is middleware counting round trips and checking what reaches it
+type counter struct {
+	next       http.RoundTripper
+	n          int
+	missing    int
+	withCookie int
+}
+
+func (c *counter) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.n++
+	if req.Header.Get("Accept") != mediaType || req.Header.Get("User-Agent") != "test" {
+		c.missing++
+	}
+	if _, err := req.Cookie(sessionCookie); err == nil {
+		c.withCookie++
+	}
+	return c.next.RoundTrip(req)
+}
+
+func TestWithTransport(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": ok(`{}`),
+	})
+	defer s.Close()
+
+	c := &counter{}
+	v := login(t, s, WithUserAgent("test"), WithTransport(func(rt http.RoundTripper) http.RoundTripper {
+		c.next = rt
+		return c
+	}))
+	if _, err := v.Overview(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// login, installation search and overview
+	if c.n != 3 || c.missing != 0 {
+		t.Errorf("%d round trips, %d without the package's headers", c.n, c.missing)
+	}
+	if c.withCookie != 2 {
+		t.Errorf("%d requests after login carried the session cookie, want 2", c.withCookie)
+	}
+}
+
+func TestWithRootCAs(t *testing.T) {
+	s := httptest.NewTLSServer(api(map[string]http.HandlerFunc{
+		"GET /installation/search": func(w http.ResponseWriter, r *http.Request) {
+			if _, err := r.Cookie(sessionCookie); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`[{"giid":"1"}]`))
+		},
+	}))
+	defer s.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(s.Certificate())
+	v := login(t, s, WithRootCAs(pool))
+	if tr := v.transport(); tr.TLSClientConfig == nil || tr.TLSClientConfig.RootCAs != pool {
+		t.Errorf("TLS config %+v", tr.TLSClientConfig)
+	}
+
+	untrusted := New(WithBaseURLs(s.URL))
+	if err := untrusted.Login(context.Background(), "user@example.com", "password"); err == nil {
+		t.Error("login to a server with an unknown CA succeeded")
+	}
+	if http.DefaultTransport.(*http.Transport).TLSClientConfig != nil &&
+		http.DefaultTransport.(*http.Transport).TLSClientConfig.RootCAs == pool {
+		t.Error("WithRootCAs changed http.DefaultTransport")
+	}
+}
@@ -0,0 +1,89 @@
+package verisure
+
+import (
+	"context"
+	"time"
+)
+
+// confirmInterval between overview reads while confirming a change
+var confirmInterval = 2 * time.Second
+
+// SetSmartplugAndVerify switches a smart plug and re-reads the overview
+// until the plug reports the requested state. ErrStateNotConfirmed is
+// returned if that does not happen within timeout.
+func (v *Verisure) SetSmartplugAndVerify(ctx context.Context, label string, on bool, timeout time.Duration) error {
+	if err := v.UpdateSmartplug(ctx, []SmartPlugState{{DeviceLabel: label, State: on}}); err != nil {
+		return err
+	}
+
+	want := "OFF"
+	if on {
+		want = "ON"
+	}
+
+	return v.confirm(ctx, timeout, func(o Overview) bool {
+		for _, p := range o.SmartPlugs {
+			if p.DeviceLabel == label {
+				return p.CurrentState == want
+			}
+		}
+		return false
+	})
+}
+
+// SetArmStateAndVerify is SetArmState followed by confirming the overview
+// reports the new state, see SetSmartplugAndVerify
+func (v *Verisure) SetArmStateAndVerify(ctx context.Context, code string, state ArmStatusType, timeout time.Duration) error {
+	if err := v.SetArmState(ctx, code, state); err != nil {
+		return err
+	}
+
+	return v.confirm(ctx, timeout, func(o Overview) bool {
+		return o.ArmState.StatusType == string(state)
+	})
+}
+
+// SetDoorLockAndVerify is SetDoorLock followed by confirming the overview
+// reports the new state, see SetSmartplugAndVerify
+func (v *Verisure) SetDoorLockAndVerify(ctx context.Context, deviceLabel, code string, lock bool, timeout time.Duration) error {
+	if err := v.SetDoorLock(ctx, deviceLabel, code, lock); err != nil {
+		return err
+	}
+
+	want := "UNLOCKED"
+	if lock {
+		want = "LOCKED"
+	}
+
+	return v.confirm(ctx, timeout, func(o Overview) bool {
+		for _, l := range o.DoorLockStatusList {
+			if l.DeviceLabel == deviceLabel {
+				return l.CurrentLockState == want
+			}
+		}
+		return false
+	})
+}
+
+// confirm reads the overview until ok accepts it or timeout passes
+func (v *Verisure) confirm(ctx context.Context, timeout time.Duration, ok func(Overview) bool) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		o, err := v.Overview(ctx)
+		if err != nil {
+			return err
+		}
+		if ok(o) {
+			return nil
+		}
+		if time.Now().Add(confirmInterval).After(deadline) {
+			return ErrStateNotConfirmed
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(confirmInterval):
+		}
+	}
+}
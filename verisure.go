@@ -9,7 +9,11 @@ import (
 	"log"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
 )
 
 var (
@@ -21,26 +25,62 @@ var (
 
 // Overview generated
 type Overview struct {
-	AccountPermissions    AccountPermissions   `json:"accountPermissions"`
-	ArmState              ArmState             `json:"armState"`
-	ArmstateCompatible    bool                 `json:"armstateCompatible"`
-	ControlPlugs          []ControlPlug        `json:"controlPlugs"`
-	SmartPlugs            []SmartPlug          `json:"smartPlugs"`
-	DoorLockStatusList    []interface{}        `json:"doorLockStatusList"`
-	TotalSmsCount         int                  `json:"totalSmsCount"`
-	ClimateValues         []ClimateValue       `json:"climateValues"`
-	InstallationErrorList []interface{}        `json:"installationErrorList"`
-	PendingChanges        int                  `json:"pendingChanges"`
-	EthernetModeActive    bool                 `json:"ethernetModeActive"`
-	EthernetConnectedNow  bool                 `json:"ethernetConnectedNow"`
-	HeatPumps             []interface{}        `json:"heatPumps"`
-	SmartCameras          []interface{}        `json:"smartCameras"`
-	LatestEthernetStatus  LatestEthernetStatus `json:"latestEthernetStatus"`
-	CustomerImageCameras  []interface{}        `json:"customerImageCameras"`
-	BatteryProcess        BatteryProcess       `json:"batteryProcess"`
-	UserTracking          UserTracking         `json:"userTracking"`
-	EventCounts           []interface{}        `json:"eventCounts"`
-	DoorWindow            DoorWindow           `json:"doorWindow"`
+	AccountPermissions    AccountPermissions         `json:"accountPermissions"`
+	ArmState              ArmState                   `json:"armState"`
+	ArmstateCompatible    bool                       `json:"armstateCompatible"`
+	ControlPlugs          []ControlPlug              `json:"controlPlugs"`
+	SmartPlugs            []SmartPlug                `json:"smartPlugs"`
+	DoorLockStatusList    []DoorLock                 `json:"doorLockStatusList"`
+	TotalSmsCount         int                        `json:"totalSmsCount"`
+	ClimateValues         []ClimateValue             `json:"climateValues"`
+	InstallationErrorList []InstallationError        `json:"installationErrorList"`
+	PendingChanges        int                        `json:"pendingChanges"`
+	EthernetModeActive    bool                       `json:"ethernetModeActive"`
+	EthernetConnectedNow  bool                       `json:"ethernetConnectedNow"`
+	HeatPumps             []interface{}              `json:"heatPumps"`
+	SmartCameras          []interface{}              `json:"smartCameras"`
+	LatestEthernetStatus  LatestEthernetStatus       `json:"latestEthernetStatus"`
+	CustomerImageCameras  []interface{}              `json:"customerImageCameras"`
+	BatteryProcess        BatteryProcess             `json:"batteryProcess"`
+	UserTracking          UserTracking               `json:"userTracking"`
+	EventCounts           []EventCount               `json:"eventCounts"`
+	DoorWindow            DoorWindow                 `json:"doorWindow"`
+	RawSections           map[string]json.RawMessage `json:"-"`
+}
+
+// InstallationError reported in the overview
+type InstallationError struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	DeviceLabel  string `json:"deviceLabel"`
+}
+
+func (e InstallationError) String() string {
+	switch {
+	case e.ErrorMessage != "":
+		return e.ErrorMessage
+	case e.ErrorCode != "":
+		return e.ErrorCode
+	}
+	return "installation error"
+}
+
+// UnmarshalJSON also accepts a bare string, taken as the message
+func (e *InstallationError) UnmarshalJSON(data []byte) error {
+	var msg string
+	if json.Unmarshal(data, &msg) == nil {
+		*e = InstallationError{ErrorMessage: msg}
+		return nil
+	}
+
+	type installationError InstallationError
+	return json.Unmarshal(data, (*installationError)(e))
+}
+
+// EventCount generated
+type EventCount struct {
+	Category string `json:"category"`
+	Count    int    `json:"count"`
 }
 
 // AccountPermissions generated
@@ -124,7 +164,8 @@ type SmartPlugState struct {
 	State       bool   `json:"state"`
 }
 
-type installation struct {
+// Installation generated
+type Installation struct {
 	GIID            string `json:"giid"`
 	FirmwareVersion int    `json:"firmwareVersion"`
 	RoutingGroup    string `json:"routingGroup"`
@@ -139,35 +180,127 @@ type installation struct {
 	Alias           string `json:"alias"`
 }
 
-// Verisure app API client
+// Verisure app API client. Login and Logout must not run concurrently with
+// other calls; other methods may be called from several goroutines.
 type Verisure struct {
-	baseURL       string
-	client        http.Client
-	installations []installation
+	sh               unsafe.Pointer // *shared, see shared
+	baseURL          string
+	baseURLs         []string
+	authURL          string
+	token            *Token
+	tokens           TokenStore
+	client           http.Client
+	middleware       []func(http.RoundTripper) http.RoundTripper
+	customClient     bool
+	tuned            bool
+	maxResponseBytes int64
+	codeLength       int
+	jitterFraction   float64
+	maxFailover      int
+	shard            int
+	locale           string
+	skipLookup       string
+	err              error
+	logger           Logger
+	installations    []Installation
+	selected         string
+	permissions      map[string]Permissions
+	subscription     map[string]Subscription
+	sessionExpiry    time.Time
+	armTx            *ArmTransaction
+	username         string
+	userAgent        string
+	climate          *climateRing
+	eventCategories  map[string][]string
+	armStates        map[string][]ArmStatusType
+	armCompat        map[string]bool
+}
+
+// shared is the state copies of a client have in common
+type shared struct {
+	mu       sync.Mutex // guards the per installation caches
+	refresh  sync.Mutex // serializes token refreshes
+	rand     *lockedRand
+	listener listener
+}
+
+func newShared() *shared {
+	return &shared{rand: newLockedRand()}
+}
+
+// shared returns the client's shared state. New sets it up, a zero
+// Verisure gets it on first use.
+func (v *Verisure) shared() *shared {
+	if p := atomic.LoadPointer(&v.sh); p != nil {
+		return (*shared)(p)
+	}
+	atomic.CompareAndSwapPointer(&v.sh, nil, unsafe.Pointer(newShared()))
+	return (*shared)(atomic.LoadPointer(&v.sh))
 }
 
 // Login ...
 func (v *Verisure) Login(ctx context.Context, username, password string) error {
+	if v.err != nil {
+		return v.err
+	}
+
+	v.permissions = nil
+	v.subscription = nil
+	v.eventCategories = nil
+	v.armStates = nil
+	v.armCompat = nil
+	v.token = nil
+	v.username = username
+	if v.resumeToken(ctx, username) {
+		return nil
+	}
 	if err := v.tryURLs(ctx, username, password); err != nil {
 		return err
 	}
 
-	return v.installation(ctx, username)
+	if err := v.installation(ctx, username); err != nil {
+		if ctx.Err() == context.DeadlineExceeded || isTimeout(err) {
+			return &SearchTimeoutError{err}
+		}
+		return err
+	}
+
+	return nil
 }
 
 func (v *Verisure) tryURLs(ctx context.Context, username, password string) error {
-	var err error
-	for _, u := range apiURLs {
+	if len(v.baseURLs) == 0 {
+		return ErrNoBaseURLs
+	}
+
+	hosts := v.failoverHosts(v.baseURLs)
+	failures := &FailoverError{Name: "login"}
+	for i, u := range hosts {
 		v.baseURL = u
-		if err = v.authenticate(ctx, username, password); err == nil {
-			break
+		err := v.authenticate(ctx, username, password)
+		if err == nil {
+			return nil
+		}
+		if isNotFound(err) {
+			if err = v.tokenLogin(ctx, username, password); err == nil {
+				return nil
+			}
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		failures.Hosts = append(failures.Hosts, u)
+		failures.Errors = append(failures.Errors, err)
+		if i+1 < len(hosts) {
+			v.logFailover(u, hosts[i+1], err)
 		}
 	}
-	return err
+
+	return failures.failed()
 }
 
 func (v *Verisure) authenticate(ctx context.Context, username, password string) error {
-	req, err := newRequest(http.MethodPost, v.baseURL+"/cookie", nil)
+	req, err := v.newRequest(http.MethodPost, v.baseURL+"/cookie", nil)
 	if err != nil {
 		return err
 	}
@@ -179,36 +312,102 @@ func (v *Verisure) authenticate(ctx context.Context, username, password string)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("login: %d %s", res.StatusCode, res.Status)
+	if err := checkStatus("login", res); err != nil {
+		return err
 	}
+	v.recordSession(res)
 
 	return nil
 }
 
 func (v *Verisure) installation(ctx context.Context, username string) error {
-	url := fmt.Sprintf("%s/installation/search?email=%s", v.baseURL, username)
-	req, err := newRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return err
+	if v.skipLookup != "" {
+		v.installations = []Installation{{GIID: v.skipLookup, Shard: v.shard, Locale: v.locale}}
+		v.selected = v.skipLookup
+		return nil
 	}
 
-	res, err := v.client.Do(req.WithContext(ctx))
-	if err != nil {
+	var raw []json.RawMessage
+	path := "/installation/search?email=" + url.QueryEscape(username)
+	if err := v.call(ctx, "installations", http.MethodGet, path, nil, &raw); err != nil {
 		return err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("installations: %d %s", res.StatusCode, res.Status)
+	// An installation that does not decode must not stop login to the others
+	v.installations = make([]Installation, 0, len(raw))
+	for _, r := range raw {
+		var inst Installation
+		if err := json.Unmarshal(r, &inst); err != nil {
+			v.logSkipped(err)
+			continue
+		}
+		v.installations = append(v.installations, inst)
+	}
+
+	for _, inst := range v.installations {
+		if inst.GIID == v.selected {
+			return nil
+		}
+	}
+	v.selected = ""
+	if len(v.installations) > 0 {
+		v.selected = v.installations[0].GIID
+	}
+
+	return nil
+}
+
+// SelectInstallation makes the installation with the given GIID the target
+// of subsequent calls. The first installation is selected after Login.
+func (v *Verisure) SelectInstallation(giid string) error {
+	for _, inst := range v.installations {
+		if inst.GIID == giid {
+			v.selected = giid
+			return nil
+		}
 	}
 
-	return json.NewDecoder(res.Body).Decode(&v.installations)
+	return fmt.Errorf("select installation: unknown giid %q", giid)
 }
 
-// Logout ...
+// ActiveInstallation returns the installation commands are sent to
+func (v *Verisure) ActiveInstallation() (Installation, error) {
+	return v.activeInstallation()
+}
+
+// activeInstallation returns the selected installation
+func (v *Verisure) activeInstallation() (Installation, error) {
+	if len(v.installations) == 0 {
+		return Installation{}, ErrNoInstallations
+	}
+	for _, inst := range v.installations {
+		if inst.GIID == v.selected {
+			return inst, nil
+		}
+	}
+
+	return Installation{}, ErrNoInstallationSelected
+}
+
+// giid of the selected installation
+func (v *Verisure) giid() (string, error) {
+	inst, err := v.activeInstallation()
+	return inst.GIID, err
+}
+
+// Logout ends the session and drops its cookies. Logging out a session the
+// API already considers invalid (401) is not an error.
 func (v *Verisure) Logout(ctx context.Context) error {
-	req, err := http.NewRequest(http.MethodDelete, v.baseURL+"/cookie", nil)
+	if v.token == nil {
+		err := v.call(ctx, "logout", http.MethodDelete, "/cookie", nil, nil)
+		if err != nil && !isUnauthorized(err) {
+			return err
+		}
+		v.clearSession()
+		return nil
+	}
+
+	req, err := v.newRequest(http.MethodDelete, v.authURL+"/logout", nil)
 	if err != nil {
 		return err
 	}
@@ -217,80 +416,238 @@ func (v *Verisure) Logout(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("logout: %d %s", res.StatusCode, res.Status)
+	if err := checkStatus("logout", res); err != nil && !isUnauthorized(err) {
+		return err
 	}
+	v.token = nil
+	v.clearSession()
 
-	return nil
+	return v.saveToken()
 }
 
 // Overview ...
 func (v *Verisure) Overview(ctx context.Context) (Overview, error) {
-	var o Overview
-	url := fmt.Sprintf("%s/installation/%s/overview", v.baseURL, v.installations[0].GIID)
-	req, err := newRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return o, err
-	}
+	o, _, err := v.OverviewRaw(ctx)
+	return o, err
+}
 
-	res, err := v.client.Do(req.WithContext(ctx))
+// OverviewRaw returns the overview along with the JSON it was decoded from
+func (v *Verisure) OverviewRaw(ctx context.Context) (Overview, json.RawMessage, error) {
+	var o Overview
+	giid, err := v.giid()
 	if err != nil {
-		return o, err
+		return o, nil, err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return o, fmt.Errorf("overview: %d %s", res.StatusCode, res.Status)
+	var raw json.RawMessage
+	path := fmt.Sprintf("/installation/%s/overview", giid)
+	if err := v.call(ctx, "overview", http.MethodGet, path, nil, &raw); err != nil {
+		return o, nil, err
 	}
 
-	err = json.NewDecoder(res.Body).Decode(&o)
-	if err != nil {
-		return o, err
+	if err := json.Unmarshal(raw, &o); err != nil {
+		return o, raw, err
 	}
+	v.rememberArmCompat(giid, raw)
 
-	return o, nil
+	return o, raw, nil
 }
 
 // UpdateSmartplug ...
 func (v *Verisure) UpdateSmartplug(ctx context.Context, updates []SmartPlugState) error {
-	bs, err := json.Marshal(updates)
+	giid, err := v.giid()
 	if err != nil {
 		return err
 	}
 
-	url := fmt.Sprintf("%s/installation/%s/smartplug/state", v.baseURL, v.installations[0].GIID)
-	req, err := newRequest(http.MethodPost, url, bytes.NewReader(bs))
+	path := fmt.Sprintf("/installation/%s/smartplug/state", giid)
+	return v.call(ctx, "smartplug", http.MethodPost, path, updates, nil)
+}
+
+// New Verisure client
+func New(opts ...Option) Verisure {
+	jar, err := cookiejar.New(nil)
 	if err != nil {
+		log.Fatal(err)
+	}
+
+	v := Verisure{
+		sh:               unsafe.Pointer(newShared()),
+		baseURLs:         apiURLs,
+		authURL:          authURL,
+		maxResponseBytes: defaultMaxResponseBytes,
+		jitterFraction:   maxJitter,
+		maxFailover:      -1,
+		client:           http.Client{Jar: jar},
+		installations:    make([]Installation, 0)}
+	for _, opt := range opts {
+		opt(&v)
+	}
+	v.buildTransport()
+
+	return v
+}
+
+// call sends a request for path to the current host and decodes the JSON
+// response into out, unless out is nil. in, when not nil, is sent as the
+// JSON request body. Network errors and 5xx responses fail over to the
+// other configured hosts, see WithMaxFailover; the first host to answer
+// becomes the current one.
+func (v *Verisure) call(ctx context.Context, name, method, path string, in, out interface{}) error {
+	if err := v.refreshToken(ctx); err != nil {
 		return err
 	}
 
+	var body []byte
+	if in != nil {
+		bs, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bs
+	}
+
+	hosts := v.failoverHosts(v.hosts())
+	failures := &FailoverError{Name: name}
+	for i, host := range hosts {
+		retry, err := v.send(ctx, name, method, host+path, body, out)
+		if err == nil {
+			v.setHost(host)
+			return nil
+		}
+		if !retry || ctx.Err() != nil {
+			return err
+		}
+		failures.Hosts = append(failures.Hosts, host)
+		failures.Errors = append(failures.Errors, err)
+		if i+1 < len(hosts) {
+			v.logFailover(host, hosts[i+1], err)
+		}
+	}
+
+	return failures.failed()
+}
+
+// send performs a single request and reports whether a failure is worth
+// retrying on another host. Only requests that are safe to repeat, or that
+// never reached the server, are retried, so commands are not run twice.
+func (v *Verisure) send(ctx context.Context, name, method, url string, body []byte, out interface{}) (bool, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := v.newRequest(method, url, r)
+	if err != nil {
+		return false, err
+	}
+
 	res, err := v.client.Do(req.WithContext(ctx))
 	if err != nil {
-		return err
+		return idempotent(method) || notSent(err), err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("smartplug: %d %s", res.StatusCode, res.Status)
+	if err := checkStatus(name, res); err != nil {
+		return idempotent(method) && res.StatusCode >= http.StatusInternalServerError, err
+	}
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return false, nil
 	}
 
-	return nil
+	return false, json.NewDecoder(v.limitBody(res.Body)).Decode(out)
 }
 
-// New Verisure client
-func New() Verisure {
-	jar, err := cookiejar.New(nil)
+// stream GETs url and returns the response body for the caller to read
+// and close
+func (v *Verisure) stream(ctx context.Context, name, url string) (io.ReadCloser, error) {
+	if err := v.refreshToken(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := v.newRequest(http.MethodGet, url, nil)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+
+	res, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(name, res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return v.limitBody(res.Body), nil
+}
+
+// hosts returns the current host followed by the other configured hosts
+func (v *Verisure) hosts() []string {
+	current := v.host()
+	hosts := []string{current}
+	for _, u := range v.baseURLs {
+		if u != current {
+			hosts = append(hosts, u)
+		}
 	}
+	return hosts
+}
 
-	return Verisure{
-		client:        http.Client{Jar: jar},
-		installations: make([]installation, 0)}
+// host requests are sent to first
+func (v *Verisure) host() string {
+	v.shared().mu.Lock()
+	defer v.shared().mu.Unlock()
+	return v.baseURL
 }
 
-func newRequest(method, url string, body io.Reader) (*http.Request, error) {
+func (v *Verisure) setHost(host string) {
+	v.shared().mu.Lock()
+	v.baseURL = host
+	v.shared().mu.Unlock()
+}
+
+type statusError struct {
+	name      string
+	code      int
+	status    string
+	errorCode string
+	message   string
+}
+
+// apiError is the body the API sends along with most error statuses
+type apiError struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// errWrongCode is the API's error code for a rejected PIN code
+const errWrongCode = "WRONG_CODE"
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s: %d %s", e.name, e.code, e.status)
+}
+
+func checkStatus(name string, res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	if res.StatusCode == http.StatusForbidden {
+		return ErrPermissionDenied
+	}
+
+	var e apiError
+	json.NewDecoder(io.LimitReader(res.Body, 4096)).Decode(&e)
+	if e.ErrorCode == errWrongCode {
+		return ErrWrongCode
+	}
+
+	return &statusError{name: name, code: res.StatusCode, status: res.Status, errorCode: e.ErrorCode, message: e.ErrorMessage}
+}
+
+func (v *Verisure) newRequest(method, url string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return req, err
@@ -298,6 +655,23 @@ func newRequest(method, url string, body io.Reader) (*http.Request, error) {
 
 	req.Header.Add("Accept", mediaType)
 	req.Header.Add("Content-Type", mediaType)
+	if v.userAgent != "" {
+		req.Header.Set("User-Agent", v.userAgent)
+	}
+	if t := v.currentToken(); t != nil && v.ownHost(req.URL) {
+		req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+	}
 
 	return req, nil
 }
+
+// ownHost reports whether u points at one of the API hosts or the auth
+// service, the only places the bearer token is sent to
+func (v *Verisure) ownHost(u *url.URL) bool {
+	for _, s := range append([]string{v.authURL}, v.baseURLs...) {
+		if h, err := url.Parse(s); err == nil && h.Scheme == u.Scheme && h.Host == u.Host {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package verisure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBeforeLogin(t *testing.T) {
+	v := New()
+	ctx := context.Background()
+	if _, err := v.Overview(ctx); err != ErrNoInstallations {
+		t.Errorf("Overview: got %v, want ErrNoInstallations", err)
+	}
+	if err := v.UpdateSmartplug(ctx, []SmartPlugState{{DeviceLabel: "SP1", State: true}}); err != ErrNoInstallations {
+		t.Errorf("UpdateSmartplug: got %v, want ErrNoInstallations", err)
+	}
+
+	v.installations = []Installation{{GIID: "1"}}
+	if _, err := v.Overview(ctx); err != ErrNoInstallationSelected {
+		t.Errorf("Overview without selection: got %v, want ErrNoInstallationSelected", err)
+	}
+}
+
+func TestOverviewRaw(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": fixture(t, "overview_raw.json"),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	o, raw, err := v.OverviewRaw(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var again Overview
+	if err := json.Unmarshal(raw, &again); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(o, again) {
+		t.Errorf("decoded %+v\nraw decodes to %+v", o, again)
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc["futureSection"]; !ok {
+		t.Errorf("raw overview lost an unmodelled section: %s", raw)
+	}
+	if o.ArmState.StatusType != string(ArmArmedHome) || len(o.SmartPlugs) != 1 {
+		t.Errorf("overview %+v", o)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/permissions": ok(`{"permissions":["ARM"]}`),
+	})
+	defer s.Close()
+
+	var v Verisure
+	WithBaseURLs(s.URL)(&v)
+	ctx := context.Background()
+	if err := v.Login(ctx, "user@example.com", "password"); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := v.Permissions(ctx); err != nil || !p.CanArm {
+		t.Errorf("Permissions = %+v, %v", p, err)
+	}
+	v.OnEvent(func(Event) {})
+	if d := v.jitter(time.Second); d != time.Second {
+		t.Errorf("jitter %v without jitter configured", d)
+	}
+}
@@ -0,0 +1,118 @@
+package verisure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// maxWatchBackoff caps the delay between failing polls
+var maxWatchBackoff = 5 * time.Minute
+
+// WatchOptions select the changes that make Watch send a snapshot
+type WatchOptions struct {
+	OnArmState   bool
+	OnDoorWindow bool
+	OnPlug       bool
+	OnClimate    bool
+}
+
+// errWatchInterval is reported by Watch for intervals that would make it
+// poll without pausing
+var errWatchInterval = errors.New("verisure: watch interval must be positive")
+
+// watchAll is used by Watch
+var watchAll = WatchOptions{true, true, true, true}
+
+func (w WatchOptions) matches(d OverviewDiff) bool {
+	return w.OnArmState && d.ArmStateChanged ||
+		w.OnDoorWindow && len(d.DoorWindows) > 0 ||
+		w.OnPlug && len(d.SmartPlugs) > 0 ||
+		w.OnClimate && len(d.ClimateValues) > 0
+}
+
+// Watch polls the overview every interval. The first snapshot and every
+// snapshot that differs from the previous one, see Overview.Diff, are sent
+// on the first channel. Failed polls are reported on the error channel,
+// dropping errors while an earlier one is unread, and retried with
+// exponential backoff until the API recovers. Both channels are closed
+// when ctx is done or the session is rejected as unauthorized; that last
+// error is always delivered. An interval that is not positive is reported
+// as an error without polling.
+func (v *Verisure) Watch(ctx context.Context, interval time.Duration) (<-chan Overview, <-chan error) {
+	return v.WatchWithOptions(ctx, interval, watchAll)
+}
+
+// WatchWithOptions is Watch only sending snapshots with the changes
+// selected by opts. Changes that are ignored are carried over, so each
+// sent snapshot is compared with the previous sent one.
+func (v *Verisure) WatchWithOptions(ctx context.Context, interval time.Duration, opts WatchOptions) (<-chan Overview, <-chan error) {
+	updates := make(chan Overview)
+	errs := make(chan error, 1)
+	if interval <= 0 {
+		errs <- errWatchInterval
+		close(updates)
+		close(errs)
+		return updates, errs
+	}
+
+	go func() {
+		defer close(updates)
+		defer close(errs)
+
+		var prev Overview
+		first := true
+		failures := 0
+		for {
+			delay := interval
+			o, err := v.Overview(ctx)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				if isUnauthorized(err) {
+					// The final error replaces an unread one
+					select {
+					case <-errs:
+					default:
+					}
+					errs <- err
+					return
+				}
+				select {
+				case errs <- err:
+				default:
+				}
+				failures++
+				delay = v.jitter(backoff(interval, failures, maxWatchBackoff))
+			default:
+				failures = 0
+				if v.climate != nil {
+					v.climate.add(o.ClimateValues)
+				}
+				if first || opts.matches(o.Diff(prev)) {
+					select {
+					case updates <- o:
+					case <-ctx.Done():
+						return
+					}
+					prev, first = o, false
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+		}
+	}()
+
+	return updates, errs
+}
+
+func isUnauthorized(err error) bool {
+	se, ok := err.(*statusError)
+	return ok && se.code == http.StatusUnauthorized
+}
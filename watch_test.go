@@ -0,0 +1,121 @@
+package verisure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWatchRecovers(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": sequence(
+			reply(http.StatusInternalServerError, `{}`),
+			reply(http.StatusInternalServerError, `{}`),
+			ok(`{"armState":{"statusType":"DISARMED"}}`)),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	updates, errs := v.Watch(ctx, 5*time.Millisecond)
+
+	o, ok := <-updates
+	if !ok || o.ArmState.StatusType != string(ArmDisarmed) {
+		t.Fatalf("update %+v, %v", o, ok)
+	}
+	if se, ok := (<-errs).(*statusError); !ok || se.code != http.StatusInternalServerError {
+		t.Errorf("error %v, want the 500", se)
+	}
+
+	cancel()
+	for range updates {
+	}
+	for range errs {
+	}
+}
+
+func TestWatchUnauthorized(t *testing.T) {
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": sequence(
+			reply(http.StatusInternalServerError, `{}`),
+			reply(http.StatusUnauthorized, `{}`)),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	updates, errs := v.Watch(context.Background(), 5*time.Millisecond)
+	for range updates {
+	}
+
+	var last error
+	for err := range errs {
+		last = err
+	}
+	if !isUnauthorized(last) {
+		t.Errorf("last error %v, want the 401", last)
+	}
+}
+
+func TestWatchWithOptionsIgnoresClimate(t *testing.T) {
+	// Every reading is newer than the one before
+	climate := func(temp, minute, armed string) http.HandlerFunc {
+		return ok(`{"armState":{"statusType":"` + armed + `"},"climateValues":[{"deviceLabel":"CL1","temperature":` +
+			temp + `,"time":"2026-01-01T08:` + minute + `:00Z"}]}`)
+	}
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": sequence(
+			climate("20", "00", "DISARMED"),
+			climate("21", "01", "DISARMED"),
+			climate("22", "02", "DISARMED"),
+			climate("23", "03", "ARMED_AWAY")),
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	updates, errs := v.WatchWithOptions(ctx, time.Millisecond, WatchOptions{OnArmState: true, OnDoorWindow: true, OnPlug: true})
+
+	first := <-updates
+	if first.ClimateValues[0].Temperature != 20 {
+		t.Errorf("first snapshot %+v", first.ClimateValues)
+	}
+	next := <-updates
+	if next.ArmState.StatusType != string(ArmArmedAway) || next.ClimateValues[0].Temperature != 23 {
+		t.Errorf("climate-only change was sent: %+v", next)
+	}
+
+	cancel()
+	for range updates {
+	}
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestWatchRejectsInterval(t *testing.T) {
+	polls := 0
+	s := newAPI(map[string]http.HandlerFunc{
+		"GET /installation/1/overview": func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			w.Write([]byte(`{}`))
+		},
+	})
+	defer s.Close()
+
+	v := login(t, s)
+	for _, interval := range []time.Duration{0, -time.Second} {
+		updates, errs := v.Watch(context.Background(), interval)
+		if err := <-errs; err != errWatchInterval {
+			t.Errorf("interval %v: got %v, want errWatchInterval", interval, err)
+		}
+		if _, ok := <-updates; ok {
+			t.Errorf("interval %v: update sent", interval)
+		}
+	}
+	if polls != 0 {
+		t.Errorf("%d polls", polls)
+	}
+}